@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"task-manager/attachment"
+	"task-manager/internal/blobstore"
+)
+
+// newBlobStore picks the attachment store from BLOB_STORE: "fs" (default)
+// keeps files under BLOB_DIR, "s3" uses the bucket described by the S3_*
+// variables.
+func newBlobStore() (attachment.BlobStore, error) {
+	switch kind := os.Getenv("BLOB_STORE"); kind {
+	case "", "fs":
+		dir := os.Getenv("BLOB_DIR")
+		if dir == "" {
+			dir = "data/blobs"
+		}
+		return blobstore.NewFSStore(dir)
+	case "s3":
+		return blobstore.NewS3Store(context.Background(), blobstore.S3Config{
+			Endpoint:  os.Getenv("S3_ENDPOINT"),
+			Region:    os.Getenv("S3_REGION"),
+			Bucket:    os.Getenv("S3_BUCKET"),
+			AccessKey: os.Getenv("S3_ACCESS_KEY"),
+			SecretKey: os.Getenv("S3_SECRET_KEY"),
+			UseSSL:    os.Getenv("S3_USE_SSL") == "true",
+		})
+	default:
+		return nil, fmt.Errorf("unknown BLOB_STORE %q", kind)
+	}
+}
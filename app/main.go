@@ -1,8 +1,10 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net"
+	_ "time/tzdata" // recurrence timezones resolve without system zoneinfo
 
 	_ "task-manager/docs"
 )
@@ -21,6 +23,28 @@ func main() {
 		panic(err)
 	}
 
+	// Purge expired trash in the background
+	go app.TrashPurge.Run(context.Background())
+
+	// Create the next occurrences of recurring tasks as they come due
+	go app.Recurrence.Run(context.Background())
+
+	// Deliver notifications and send due-soon reminders
+	go app.Notifications.Run(context.Background())
+	go app.Reminders.Run(context.Background())
+
+	// Relay task events from the outbox to notifications, webhooks and live streams
+	go app.Outbox.Run(context.Background())
+
+	// Stream the task events relayed by every instance to this one's clients
+	go app.Stream.Run(context.Background())
+
+	// Send task events to project webhooks
+	go app.Webhooks.Run(context.Background())
+
+	// Keep the token signing keys current as Keycloak rotates them
+	go app.Keys.Run(context.Background())
+
 	// Start gRPC server in a goroutine
 	go func() {
 		lis, err := net.Listen("tcp", ":50051")
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"task-manager/notification"
+)
+
+// newNotificationChannels sets up the in-app and webhook channels, plus email
+// when SMTP_HOST is set. SMTP_PORT defaults to 587; SMTP_USERNAME and
+// SMTP_PASSWORD are only needed by servers that require auth.
+func newNotificationChannels(inbox notification.InboxRepository, cfg notification.Config) ([]notification.Channel, error) {
+	channels := []notification.Channel{
+		notification.NewInAppChannel(inbox),
+		notification.NewWebhookChannel(cfg),
+	}
+
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		return channels, nil
+	}
+	port := 587
+	if raw := os.Getenv("SMTP_PORT"); raw != "" {
+		p, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SMTP_PORT: %q", raw)
+		}
+		port = p
+	}
+	from := os.Getenv("SMTP_FROM")
+	if from == "" {
+		return nil, fmt.Errorf("SMTP_FROM is required when SMTP_HOST is set")
+	}
+
+	return append(channels, notification.NewEmailChannel(notification.SMTPConfig{
+		Host:     host,
+		Port:     port,
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     from,
+	})), nil
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"task-manager/notification"
+	"task-manager/outbox"
+	"task-manager/stream"
+	"task-manager/webhook"
+)
+
+// newOutboxSubscribers lists what reacts to the task events relayed from the
+// outbox.
+func newOutboxSubscribers(
+	notifications *notification.Service,
+	webhooks *webhook.Service,
+	live *stream.Fanout,
+) []outbox.Subscriber {
+	return []outbox.Subscriber{notifications, webhooks, live}
+}
@@ -4,52 +4,161 @@
 package main
 
 import (
+	"task-manager/attachment"
 	"task-manager/auth"
+	"task-manager/authz"
 	"task-manager/internal/grpc"
 	middleware2 "task-manager/internal/grpc/middleware"
+	"task-manager/internal/job"
 	"task-manager/internal/repository/postgres"
 	"task-manager/internal/rest"
 	"task-manager/internal/rest/middleware"
+	"task-manager/label"
+	"task-manager/notification"
+	"task-manager/outbox"
 	"task-manager/pkg/jwtutil"
 	kc "task-manager/pkg/keycloak"
 	"task-manager/project"
+	"task-manager/recurrence"
+	"task-manager/search"
+	"task-manager/stream"
 	"task-manager/task"
 	"task-manager/user"
+	"task-manager/webhook"
 
 	"github.com/google/wire"
 )
 
 type App struct {
-	RestServer *rest.Server
-	GrpcServer *grpc.Server
+	RestServer    *rest.Server
+	GrpcServer    *grpc.Server
+	TrashPurge    *job.TrashPurge
+	Recurrence    *job.RecurrenceScheduler
+	Notifications *job.NotificationDispatcher
+	Reminders     *job.DueReminder
+	Webhooks      *job.WebhookDispatcher
+	Outbox        *job.OutboxRelay
+	Keys          *job.JWKSRefresh
+	Stream        *job.StreamFanout
 }
 
-func NewApp(rest *rest.Server, grpc *grpc.Server) *App {
+func NewApp(
+	rest *rest.Server,
+	grpc *grpc.Server,
+	trashPurge *job.TrashPurge,
+	recurrence *job.RecurrenceScheduler,
+	notifications *job.NotificationDispatcher,
+	reminders *job.DueReminder,
+	webhooks *job.WebhookDispatcher,
+	outbox *job.OutboxRelay,
+	keys *job.JWKSRefresh,
+	stream *job.StreamFanout,
+) *App {
 	return &App{
-		RestServer: rest,
-		GrpcServer: grpc,
+		RestServer:    rest,
+		GrpcServer:    grpc,
+		TrashPurge:    trashPurge,
+		Recurrence:    recurrence,
+		Notifications: notifications,
+		Reminders:     reminders,
+		Webhooks:      webhooks,
+		Outbox:        outbox,
+		Keys:          keys,
+		Stream:        stream,
 	}
 }
 
 func InitializeApp() (*App, error) {
 	wire.Build(
-		jwtutil.FetchRSAPublicKeyFromJWKS,
+		jwtutil.JWKSConfigFromEnv,
+		jwtutil.NewJWKSCache,
+		wire.Bind(new(jwtutil.KeySource), new(*jwtutil.JWKSCache)),
+		jwtutil.ConfigFromEnv,
+		jwtutil.NewValidator,
 
 		postgres.NewDB,
 		postgres.NewTaskRepository,
 		postgres.NewCommentRepository,
+		postgres.NewWorkflowRepository,
+		postgres.NewDependencyRepository,
+		postgres.NewLabelRepository,
+		postgres.NewActivityRepository,
+		postgres.NewTransactor,
+		postgres.NewSearchRepository,
+		postgres.NewMentionRepository,
+		postgres.NewAttachmentRepository,
+		postgres.NewSeriesRepository,
+		postgres.NewNotificationRepository,
+		postgres.NewNotificationPreferenceRepository,
+		postgres.NewInboxRepository,
+		postgres.NewReminderRepository,
+		postgres.NewWebhookRepository,
+		postgres.NewWebhookDeliveryRepository,
+		postgres.NewOutboxRepository,
+		postgres.NewProjectRepository,
+		postgres.NewStreamLogRepository,
+
+		newBlobStore,
+		attachment.LimitsFromEnv,
 
 		kc.NewClient,
+		newNotificationChannels,
+		notification.ConfigFromEnv,
+		webhook.ConfigFromEnv,
+		newOutboxSubscribers,
+		outbox.ConfigFromEnv,
+		stream.ConfigFromEnv,
 
 		wire.Bind(new(task.Repository), new(*postgres.TaskRepository)),
 		wire.Bind(new(task.CommentRepository), new(*postgres.CommentRepository)),
+		wire.Bind(new(task.WorkflowRepository), new(*postgres.WorkflowRepository)),
+		wire.Bind(new(task.DependencyRepository), new(*postgres.DependencyRepository)),
+		wire.Bind(new(task.LabelRepository), new(*postgres.LabelRepository)),
+		wire.Bind(new(task.ActivityRepository), new(*postgres.ActivityRepository)),
+		wire.Bind(new(task.Transactor), new(*postgres.Transactor)),
+		wire.Bind(new(task.MentionRepository), new(*postgres.MentionRepository)),
+		wire.Bind(new(task.UserDirectory), new(*kc.Client)),
+		wire.Bind(new(task.Outbox), new(*postgres.OutboxRepository)),
+		wire.Bind(new(label.Repository), new(*postgres.LabelRepository)),
 		wire.Bind(new(user.TaskRepository), new(*postgres.TaskRepository)),
+		wire.Bind(new(user.MentionRepository), new(*postgres.MentionRepository)),
+		wire.Bind(new(project.Repository), new(*postgres.ProjectRepository)),
 		wire.Bind(new(project.TaskRepository), new(*postgres.TaskRepository)),
+		wire.Bind(new(search.Repository), new(*postgres.SearchRepository)),
+		wire.Bind(new(attachment.Repository), new(*postgres.AttachmentRepository)),
+		wire.Bind(new(attachment.TaskRepository), new(*postgres.TaskRepository)),
+		wire.Bind(new(attachment.CommentRepository), new(*postgres.CommentRepository)),
+		wire.Bind(new(recurrence.SeriesRepository), new(*postgres.SeriesRepository)),
+		wire.Bind(new(recurrence.TaskRepository), new(*postgres.TaskRepository)),
+		wire.Bind(new(recurrence.TaskService), new(*task.Service)),
+		wire.Bind(new(recurrence.Transactor), new(*postgres.Transactor)),
+		wire.Bind(new(notification.Repository), new(*postgres.NotificationRepository)),
+		wire.Bind(new(notification.PreferenceRepository), new(*postgres.NotificationPreferenceRepository)),
+		wire.Bind(new(notification.InboxRepository), new(*postgres.InboxRepository)),
+		wire.Bind(new(notification.ReminderRepository), new(*postgres.ReminderRepository)),
+		wire.Bind(new(notification.Transactor), new(*postgres.Transactor)),
+		wire.Bind(new(webhook.Repository), new(*postgres.WebhookRepository)),
+		wire.Bind(new(webhook.DeliveryRepository), new(*postgres.WebhookDeliveryRepository)),
+		wire.Bind(new(outbox.Repository), new(*postgres.OutboxRepository)),
+		wire.Bind(new(authz.MemberRepository), new(*postgres.ProjectRepository)),
+		wire.Bind(new(stream.Log), new(*postgres.StreamLogRepository)),
+
+		authz.NewRoleAuthorizer,
+		wire.Bind(new(authz.Authorizer), new(*authz.RoleAuthorizer)),
 
 		auth.NewService,
 		task.NewService,
 		user.NewService,
 		project.NewService,
+		label.NewService,
+		search.NewService,
+		attachment.NewService,
+		recurrence.NewService,
+		notification.NewService,
+		webhook.NewService,
+		outbox.NewRelay,
+		stream.NewBroker,
+		stream.NewFanout,
 
 		middleware.JWTAuthMiddleware,
 
@@ -57,18 +166,52 @@ func InitializeApp() (*App, error) {
 		wire.Bind(new(rest.TaskService), new(*task.Service)),
 		wire.Bind(new(rest.UserService), new(*user.Service)),
 		wire.Bind(new(rest.ProjectService), new(*project.Service)),
+		wire.Bind(new(rest.WorkflowService), new(*task.Service)),
+		wire.Bind(new(rest.LabelService), new(*label.Service)),
+		wire.Bind(new(rest.SearchService), new(*search.Service)),
+		wire.Bind(new(rest.AttachmentService), new(*attachment.Service)),
+		wire.Bind(new(rest.RecurrenceService), new(*recurrence.Service)),
+		wire.Bind(new(rest.NotificationService), new(*notification.Service)),
+		wire.Bind(new(rest.WebhookService), new(*webhook.Service)),
+		wire.Bind(new(rest.EventStream), new(*stream.Broker)),
 
 		rest.NewServer,
 
 		middleware2.NewJWTUnaryInterceptor,
+		middleware2.NewJWTStreamInterceptor,
 
 		wire.Bind(new(grpc.AuthService), new(*auth.Service)),
 		wire.Bind(new(grpc.TaskService), new(*task.Service)),
 		wire.Bind(new(grpc.UserService), new(*user.Service)),
 		wire.Bind(new(grpc.ProjectService), new(*project.Service)),
+		wire.Bind(new(grpc.LabelService), new(*label.Service)),
+		wire.Bind(new(grpc.SearchService), new(*search.Service)),
+		wire.Bind(new(grpc.AttachmentService), new(*attachment.Service)),
+		wire.Bind(new(grpc.RecurrenceService), new(*recurrence.Service)),
+		wire.Bind(new(grpc.NotificationService), new(*notification.Service)),
+		wire.Bind(new(grpc.WebhookService), new(*webhook.Service)),
+		wire.Bind(new(grpc.TaskWatcher), new(*stream.Broker)),
 
 		grpc.NewServer,
 
+		wire.Bind(new(job.TrashPurger), new(*task.Service)),
+		wire.Bind(new(job.AttachmentPurger), new(*attachment.Service)),
+		job.NewTrashPurge,
+		wire.Bind(new(job.OccurrenceMaterializer), new(*recurrence.Service)),
+		job.NewRecurrenceScheduler,
+		wire.Bind(new(job.NotificationDeliverer), new(*notification.Service)),
+		job.NewNotificationDispatcher,
+		wire.Bind(new(job.DueSoonReminder), new(*notification.Service)),
+		job.NewDueReminder,
+		wire.Bind(new(job.WebhookDeliverer), new(*webhook.Service)),
+		job.NewWebhookDispatcher,
+		wire.Bind(new(job.OutboxRelayer), new(*outbox.Relay)),
+		job.NewOutboxRelay,
+		wire.Bind(new(job.KeyRefresher), new(*jwtutil.JWKSCache)),
+		job.NewJWKSRefresh,
+		wire.Bind(new(job.StreamFollower), new(*stream.Fanout)),
+		job.NewStreamFanout,
+
 		NewApp,
 	)
 
@@ -7,31 +7,48 @@
 package main
 
 import (
+	"task-manager/attachment"
 	"task-manager/auth"
+	"task-manager/authz"
 	"task-manager/internal/grpc"
 	middleware2 "task-manager/internal/grpc/middleware"
+	"task-manager/internal/job"
 	"task-manager/internal/repository/postgres"
 	"task-manager/internal/rest"
 	"task-manager/internal/rest/middleware"
+	"task-manager/label"
+	"task-manager/notification"
+	"task-manager/outbox"
 	"task-manager/pkg/jwtutil"
 	"task-manager/pkg/keycloak"
 	"task-manager/project"
+	"task-manager/recurrence"
+	"task-manager/search"
+	"task-manager/stream"
 	"task-manager/task"
 	"task-manager/user"
+	"task-manager/webhook"
 )
 
 import (
 	_ "task-manager/docs"
+	_ "time/tzdata"
 )
 
 // Injectors from wire.go:
 
 func InitializeApp() (*App, error) {
-	publicKey, err := jwtutil.FetchRSAPublicKeyFromJWKS()
+	config, err := jwtutil.ConfigFromEnv()
 	if err != nil {
 		return nil, err
 	}
-	handlerFunc := middleware.JWTAuthMiddleware(publicKey)
+	jwksConfig, err := jwtutil.JWKSConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	jwksCache := jwtutil.NewJWKSCache(jwksConfig)
+	validator := jwtutil.NewValidator(config, jwksCache)
+	handlerFunc := middleware.JWTAuthMiddleware(validator)
 	client := keycloak.NewClient()
 	service := auth.NewService(client)
 	db, err := postgres.NewDB()
@@ -40,26 +57,138 @@ func InitializeApp() (*App, error) {
 	}
 	taskRepository := postgres.NewTaskRepository(db)
 	commentRepository := postgres.NewCommentRepository(db)
-	taskService := task.NewService(taskRepository, commentRepository)
-	userService := user.NewService(taskRepository)
-	projectService := project.NewService(taskRepository)
-	server := rest.NewServer(handlerFunc, service, taskService, userService, projectService)
-	unaryServerInterceptor := middleware2.NewJWTUnaryInterceptor(publicKey)
-	grpcServer := grpc.NewServer(unaryServerInterceptor, service, taskService, userService, projectService)
-	app := NewApp(server, grpcServer)
+	workflowRepository := postgres.NewWorkflowRepository(db)
+	dependencyRepository := postgres.NewDependencyRepository(db)
+	labelRepository := postgres.NewLabelRepository(db)
+	activityRepository := postgres.NewActivityRepository(db)
+	mentionRepository := postgres.NewMentionRepository(db)
+	outboxRepository := postgres.NewOutboxRepository(db)
+	transactor := postgres.NewTransactor(db)
+	projectRepository := postgres.NewProjectRepository(db)
+	roleAuthorizer := authz.NewRoleAuthorizer(projectRepository)
+	taskService := task.NewService(taskRepository, commentRepository, workflowRepository, dependencyRepository, labelRepository, activityRepository, mentionRepository, client, outboxRepository, transactor, roleAuthorizer)
+	userService := user.NewService(taskRepository, mentionRepository)
+	projectService := project.NewService(projectRepository, taskRepository, roleAuthorizer)
+	labelService := label.NewService(labelRepository, roleAuthorizer)
+	searchRepository := postgres.NewSearchRepository(db)
+	searchService := search.NewService(searchRepository, roleAuthorizer)
+	attachmentRepository := postgres.NewAttachmentRepository(db)
+	blobStore, err := newBlobStore()
+	if err != nil {
+		return nil, err
+	}
+	limits, err := attachment.LimitsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	attachmentService := attachment.NewService(attachmentRepository, blobStore, taskRepository, commentRepository, roleAuthorizer, limits)
+	seriesRepository := postgres.NewSeriesRepository(db)
+	recurrenceService := recurrence.NewService(seriesRepository, taskRepository, taskService, transactor, roleAuthorizer)
+	notificationRepository := postgres.NewNotificationRepository(db)
+	notificationPreferenceRepository := postgres.NewNotificationPreferenceRepository(db)
+	inboxRepository := postgres.NewInboxRepository(db)
+	reminderRepository := postgres.NewReminderRepository(db)
+	notificationConfig, err := notification.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	v, err := newNotificationChannels(inboxRepository, notificationConfig)
+	if err != nil {
+		return nil, err
+	}
+	notificationService := notification.NewService(notificationRepository, notificationPreferenceRepository, inboxRepository, reminderRepository, transactor, v, notificationConfig)
+	webhookRepository := postgres.NewWebhookRepository(db)
+	webhookDeliveryRepository := postgres.NewWebhookDeliveryRepository(db)
+	webhookConfig, err := webhook.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	webhookService := webhook.NewService(webhookRepository, webhookDeliveryRepository, roleAuthorizer, webhookConfig)
+	streamConfig, err := stream.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	broker := stream.NewBroker(streamConfig)
+	server := rest.NewServer(handlerFunc, service, taskService, userService, projectService, taskService, labelService, searchService, attachmentService, recurrenceService, notificationService, webhookService, broker)
+	unaryServerInterceptor := middleware2.NewJWTUnaryInterceptor(validator)
+	streamServerInterceptor := middleware2.NewJWTStreamInterceptor(validator)
+	v2 := grpc.NewServer(unaryServerInterceptor, streamServerInterceptor, service, taskService, userService, projectService, labelService, searchService, attachmentService, recurrenceService, notificationService, webhookService, broker)
+	trashPurge, err := job.NewTrashPurge(taskService, attachmentService)
+	if err != nil {
+		return nil, err
+	}
+	recurrenceScheduler, err := job.NewRecurrenceScheduler(recurrenceService)
+	if err != nil {
+		return nil, err
+	}
+	notificationDispatcher, err := job.NewNotificationDispatcher(notificationService)
+	if err != nil {
+		return nil, err
+	}
+	dueReminder, err := job.NewDueReminder(notificationService)
+	if err != nil {
+		return nil, err
+	}
+	webhookDispatcher, err := job.NewWebhookDispatcher(webhookService)
+	if err != nil {
+		return nil, err
+	}
+	streamLogRepository := postgres.NewStreamLogRepository(db)
+	fanout := stream.NewFanout(streamLogRepository, broker, streamConfig)
+	v3 := newOutboxSubscribers(notificationService, webhookService, fanout)
+	outboxConfig, err := outbox.ConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	relay := outbox.NewRelay(outboxRepository, v3, outboxConfig)
+	outboxRelay, err := job.NewOutboxRelay(relay)
+	if err != nil {
+		return nil, err
+	}
+	jwksRefresh, err := job.NewJWKSRefresh(jwksCache)
+	if err != nil {
+		return nil, err
+	}
+	streamFanout, err := job.NewStreamFanout(fanout)
+	if err != nil {
+		return nil, err
+	}
+	app := NewApp(server, v2, trashPurge, recurrenceScheduler, notificationDispatcher, dueReminder, webhookDispatcher, outboxRelay, jwksRefresh, streamFanout)
 	return app, nil
 }
 
 // wire.go:
 
 type App struct {
-	RestServer *rest.Server
-	GrpcServer *grpc.Server
+	RestServer    *rest.Server
+	GrpcServer    *grpc.Server
+	TrashPurge    *job.TrashPurge
+	Recurrence    *job.RecurrenceScheduler
+	Notifications *job.NotificationDispatcher
+	Reminders     *job.DueReminder
+	Webhooks      *job.WebhookDispatcher
+	Outbox        *job.OutboxRelay
+	Keys          *job.JWKSRefresh
+	Stream        *job.StreamFanout
 }
 
-func NewApp(rest2 *rest.Server, grpc2 *grpc.Server) *App {
+func NewApp(rest2 *rest.Server, grpc2 *grpc.Server,
+	trashPurge *job.TrashPurge, recurrence2 *job.RecurrenceScheduler,
+	notifications *job.NotificationDispatcher,
+	reminders *job.DueReminder,
+	webhooks *job.WebhookDispatcher, outbox2 *job.OutboxRelay,
+	keys *job.JWKSRefresh, stream2 *job.StreamFanout,
+) *App {
 	return &App{
-		RestServer: rest2,
-		GrpcServer: grpc2,
+		RestServer:    rest2,
+		GrpcServer:    grpc2,
+		TrashPurge:    trashPurge,
+		Recurrence:    recurrence2,
+		Notifications: notifications,
+		Reminders:     reminders,
+		Webhooks:      webhooks,
+		Outbox:        outbox2,
+		Keys:          keys,
+		Stream:        stream2,
 	}
 }
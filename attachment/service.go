@@ -0,0 +1,361 @@
+package attachment
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"task-manager/authz"
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, attachment *domain.Attachment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error)
+	ListByTask(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error)
+	// ListTrashed returns attachments whose task or comment went to the trash before the given time.
+	ListTrashed(ctx context.Context, before time.Time) ([]domain.Attachment, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// BlobStore keeps attachment content. Keys are slash-separated paths.
+type BlobStore interface {
+	// Put stores r under key. size is the content length, or -1 when unknown.
+	Put(ctx context.Context, key string, r io.Reader, size int64, contentType string) error
+	// Get opens the content under key, returning domain.ErrNotFound when there is none.
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// TaskRepository checks that the task being attached to exists.
+type TaskRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+}
+
+// CommentRepository checks that the comment being attached to exists.
+type CommentRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error)
+}
+
+// Limits bound what can be uploaded.
+type Limits struct {
+	// MaxSize is the largest accepted upload in bytes.
+	MaxSize int64
+	// AllowedTypes lists accepted media types. An entry ending in "/*"
+	// accepts a whole family, such as "image/*".
+	AllowedTypes []string
+}
+
+var DefaultLimits = Limits{
+	MaxSize: 25 << 20,
+	AllowedTypes: []string{
+		"image/*",
+		"text/plain",
+		"text/csv",
+		"application/pdf",
+		"application/json",
+		"application/zip",
+		"application/x-gzip",
+	},
+}
+
+// LimitsFromEnv reads ATTACHMENT_MAX_BYTES and the comma-separated
+// ATTACHMENT_ALLOWED_TYPES, falling back to DefaultLimits.
+func LimitsFromEnv() (Limits, error) {
+	limits := DefaultLimits
+	if raw := os.Getenv("ATTACHMENT_MAX_BYTES"); raw != "" {
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil || n <= 0 {
+			return Limits{}, fmt.Errorf("invalid ATTACHMENT_MAX_BYTES: %q", raw)
+		}
+		limits.MaxSize = n
+	}
+	if raw := os.Getenv("ATTACHMENT_ALLOWED_TYPES"); raw != "" {
+		limits.AllowedTypes = nil
+		for _, t := range strings.Split(raw, ",") {
+			if t = strings.TrimSpace(t); t != "" {
+				limits.AllowedTypes = append(limits.AllowedTypes, t)
+			}
+		}
+	}
+	return limits, nil
+}
+
+type Service struct {
+	repo        Repository
+	blobs       BlobStore
+	taskRepo    TaskRepository
+	commentRepo CommentRepository
+	authorizer  authz.Authorizer
+	limits      Limits
+}
+
+func NewService(
+	repo Repository,
+	blobs BlobStore,
+	taskRepo TaskRepository,
+	commentRepo CommentRepository,
+	authorizer authz.Authorizer,
+	limits Limits,
+) *Service {
+	return &Service{
+		repo:        repo,
+		blobs:       blobs,
+		taskRepo:    taskRepo,
+		commentRepo: commentRepo,
+		authorizer:  authorizer,
+		limits:      limits,
+	}
+}
+
+// Upload streams r into the blob store and records the attachment. The media
+// type is sniffed from the content rather than trusted from the client, and
+// the SHA-256 checksum is computed on the way through. size is the declared
+// length, or -1 when unknown; oversized uploads are refused before reading
+// when it is known.
+func (s *Service) Upload(
+	ctx context.Context,
+	taskID uuid.UUID,
+	commentID *uuid.UUID,
+	uploaderID uuid.UUID,
+	fileName string,
+	size int64,
+	r io.Reader,
+) (*domain.Attachment, error) {
+	// Files on a comment are part of commenting; files on the task edit it.
+	permission := domain.PermissionEdit
+	if commentID != nil {
+		permission = domain.PermissionComment
+	}
+	if _, err := s.readTask(ctx, taskID, permission); err != nil {
+		return nil, err
+	}
+	if commentID != nil {
+		comment, err := s.commentRepo.GetByID(ctx, *commentID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: comment not found", domain.ErrInvalidAttachment)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if comment.TaskID != taskID {
+			return nil, fmt.Errorf("%w: comment belongs to another task", domain.ErrInvalidAttachment)
+		}
+	}
+
+	fileName = cleanFileName(fileName)
+	if fileName == "" {
+		return nil, fmt.Errorf("%w: file name is required", domain.ErrInvalidAttachment)
+	}
+	if size > s.limits.MaxSize {
+		return nil, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, s.limits.MaxSize)
+	}
+
+	br := bufio.NewReaderSize(r, 512)
+	head, err := br.Peek(512)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", domain.ErrInvalidAttachment)
+	}
+	contentType := detectContentType(head, fileName)
+	if !s.allowed(contentType) {
+		return nil, fmt.Errorf("%w: %s", domain.ErrUnsupportedMediaType, contentType)
+	}
+
+	a := &domain.Attachment{
+		ID:          uuid.New(),
+		TaskID:      taskID,
+		CommentID:   commentID,
+		UploaderID:  uploaderID,
+		FileName:    fileName,
+		ContentType: contentType,
+		CreatedAt:   time.Now(),
+	}
+	a.StorageKey = path.Join("tasks", taskID.String(), a.ID.String())
+
+	hash := sha256.New()
+	counter := &countingReader{r: io.LimitReader(br, s.limits.MaxSize+1)}
+	if err := s.blobs.Put(ctx, a.StorageKey, io.TeeReader(counter, hash), size, contentType); err != nil {
+		return nil, err
+	}
+	if counter.n > s.limits.MaxSize {
+		s.discard(ctx, a.StorageKey)
+		return nil, fmt.Errorf("%w: limit is %d bytes", domain.ErrAttachmentTooLarge, s.limits.MaxSize)
+	}
+	a.Size = counter.n
+	a.SHA256 = hex.EncodeToString(hash.Sum(nil))
+
+	if err := s.repo.Create(ctx, a); err != nil {
+		s.discard(ctx, a.StorageKey)
+		return nil, err
+	}
+	return a, nil
+}
+
+func (s *Service) List(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error) {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByTask(ctx, taskID)
+}
+
+// Open returns an attachment of the task and a reader over its content,
+// which the caller must close.
+func (s *Service) Open(ctx context.Context, taskID, id uuid.UUID) (*domain.Attachment, io.ReadCloser, error) {
+	a, err := s.taskAttachment(ctx, taskID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	content, err := s.blobs.Get(ctx, a.StorageKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	return a, content, nil
+}
+
+// Delete removes an attachment. Its uploader and those who may delete in the
+// project may delete it.
+func (s *Service) Delete(ctx context.Context, taskID, id, userID uuid.UUID) error {
+	a, err := s.taskAttachment(ctx, taskID, id)
+	if err != nil {
+		return err
+	}
+	if a.UploaderID != userID {
+		task, err := s.taskRepo.GetByID(ctx, taskID)
+		if err != nil {
+			return err
+		}
+		err = s.authorizer.Require(ctx, task.ProjectID, domain.PermissionDelete)
+		if errors.Is(err, domain.ErrForbidden) {
+			return fmt.Errorf("%w: only the uploader or a project administrator can delete an attachment", domain.ErrForbidden)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	s.discard(ctx, a.StorageKey)
+	return nil
+}
+
+// PurgeTrashed permanently removes the attachments of tasks and comments that
+// have been in the trash for longer than retention, and returns how many were
+// removed. It runs ahead of the task purge, which would otherwise drop the
+// rows that know where the content is stored.
+func (s *Service) PurgeTrashed(ctx context.Context, retention time.Duration) (int64, error) {
+	trashed, err := s.repo.ListTrashed(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for _, a := range trashed {
+		if err := s.blobs.Delete(ctx, a.StorageKey); err != nil {
+			return purged, err
+		}
+		if err := s.repo.Delete(ctx, a.ID); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+func (s *Service) taskAttachment(ctx context.Context, taskID, id uuid.UUID) (*domain.Attachment, error) {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	a, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.TaskID != taskID {
+		return nil, domain.ErrNotFound
+	}
+	return a, nil
+}
+
+// readTask loads a task once the caller is known to hold the permission in
+// its project.
+func (s *Service) readTask(ctx context.Context, id uuid.UUID, permission domain.Permission) (*domain.Task, error) {
+	return authz.ReadTask(ctx, s.authorizer, s.taskRepo, id, permission)
+}
+
+// discard removes content that has no metadata row. Failures only leave an
+// unreachable blob behind, so they are logged rather than returned.
+func (s *Service) discard(ctx context.Context, key string) {
+	if err := s.blobs.Delete(ctx, key); err != nil {
+		log.Printf("delete blob %s: %v", key, err)
+	}
+}
+
+func (s *Service) allowed(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range s.limits.AllowedTypes {
+		if family, ok := strings.CutSuffix(allowed, "/*"); ok {
+			if strings.HasPrefix(mediaType, family+"/") {
+				return true
+			}
+		} else if mediaType == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// detectContentType sniffs the content. Text is refined by the file
+// extension so that CSV and JSON files keep their specific types.
+func detectContentType(head []byte, fileName string) string {
+	detected := http.DetectContentType(head)
+	if strings.HasPrefix(detected, "text/plain") {
+		switch strings.ToLower(filepath.Ext(fileName)) {
+		case ".csv":
+			return "text/csv"
+		case ".json":
+			return "application/json"
+		}
+	}
+	return detected
+}
+
+// cleanFileName keeps the base name of a client-supplied path.
+func cleanFileName(name string) string {
+	name = filepath.Base(strings.ReplaceAll(strings.TrimSpace(name), `\`, "/"))
+	if name == "." || name == "/" {
+		return ""
+	}
+	return name
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
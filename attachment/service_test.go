@@ -0,0 +1,206 @@
+package attachment_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"task-manager/attachment"
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryBlobs is an attachment.BlobStore in memory.
+type memoryBlobs map[string][]byte
+
+func (b memoryBlobs) Put(_ context.Context, key string, r io.Reader, _ int64, _ string) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	b[key] = data
+	return nil
+}
+
+func (b memoryBlobs) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	data, ok := b[key]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (b memoryBlobs) Delete(_ context.Context, key string) error {
+	delete(b, key)
+	return nil
+}
+
+// memoryAttachments is an attachment.Repository in memory whose Create fails
+// with err when it is set.
+type memoryAttachments struct {
+	stored map[uuid.UUID]domain.Attachment
+	err    error
+}
+
+func (r *memoryAttachments) Create(_ context.Context, a *domain.Attachment) error {
+	if r.err != nil {
+		return r.err
+	}
+	r.stored[a.ID] = *a
+	return nil
+}
+
+func (r *memoryAttachments) GetByID(_ context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	a, ok := r.stored[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &a, nil
+}
+
+func (r *memoryAttachments) ListByTask(context.Context, uuid.UUID) ([]domain.Attachment, error) {
+	return nil, nil
+}
+
+func (r *memoryAttachments) ListTrashed(context.Context, time.Time) ([]domain.Attachment, error) {
+	return nil, nil
+}
+
+func (r *memoryAttachments) Delete(_ context.Context, id uuid.UUID) error {
+	delete(r.stored, id)
+	return nil
+}
+
+type oneTask struct{ task domain.Task }
+
+func (t oneTask) GetByID(_ context.Context, id uuid.UUID) (*domain.Task, error) {
+	if id != t.task.ID {
+		return nil, domain.ErrNotFound
+	}
+	return &t.task, nil
+}
+
+type noComments struct{}
+
+func (noComments) GetByID(context.Context, uuid.UUID) (*domain.Comment, error) {
+	return nil, domain.ErrNotFound
+}
+
+type allowAll struct{}
+
+func (allowAll) Require(context.Context, uuid.UUID, domain.Permission) error {
+	return nil
+}
+
+// unreadable fails the test's upload if the service reads it.
+type unreadable struct{}
+
+func (unreadable) Read([]byte) (int, error) {
+	return 0, errors.New("content was read")
+}
+
+func TestService_Upload(t *testing.T) {
+	sum := func(s string) string {
+		h := sha256.Sum256([]byte(s))
+		return hex.EncodeToString(h[:])
+	}
+	oversized := strings.Repeat("a", 33)
+	errDown := errors.New("database down")
+
+	tests := []struct {
+		name      string
+		fileName  string
+		size      int64
+		content   io.Reader
+		createErr error
+		wantType  string
+		wantSum   string
+		wantErr   error
+	}{
+		{
+			name:     "stores content with its checksum",
+			fileName: "notes.txt",
+			size:     11,
+			content:  strings.NewReader("hello world"),
+			wantType: "text/plain; charset=utf-8",
+			wantSum:  sum("hello world"),
+		},
+		{
+			name:     "refines text by extension",
+			fileName: "data.csv",
+			size:     -1,
+			content:  strings.NewReader("a,b\n1,2\n"),
+			wantType: "text/csv",
+			wantSum:  sum("a,b\n1,2\n"),
+		},
+		{
+			name:     "refuses a declared size over the limit before reading",
+			fileName: "big.txt",
+			size:     33,
+			content:  unreadable{},
+			wantErr:  domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:     "refuses content over the limit when the size is unknown",
+			fileName: "big.txt",
+			size:     -1,
+			content:  strings.NewReader(oversized),
+			wantErr:  domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:     "refuses content longer than declared",
+			fileName: "big.txt",
+			size:     8,
+			content:  strings.NewReader(oversized),
+			wantErr:  domain.ErrAttachmentTooLarge,
+		},
+		{
+			name:     "sniffs the type rather than trusting the name",
+			fileName: "photo.png",
+			size:     -1,
+			content:  strings.NewReader("<html><script>alert(1)</script></html>"),
+			wantErr:  domain.ErrUnsupportedMediaType,
+		},
+		{
+			name:      "deletes the content when it cannot be recorded",
+			fileName:  "notes.txt",
+			size:      -1,
+			content:   strings.NewReader("hello world"),
+			createErr: errDown,
+			wantErr:   errDown,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			task := domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+			blobs := memoryBlobs{}
+			repo := &memoryAttachments{stored: map[uuid.UUID]domain.Attachment{}, err: tt.createErr}
+			limits := attachment.Limits{MaxSize: 32, AllowedTypes: []string{"text/plain", "text/csv", "image/*"}}
+			svc := attachment.NewService(repo, blobs, oneTask{task}, noComments{}, allowAll{}, limits)
+
+			a, err := svc.Upload(context.Background(), task.ID, nil, uuid.New(), tt.fileName, tt.size, tt.content)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				assert.Empty(t, blobs)
+				assert.Empty(t, repo.stored)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, a.ContentType)
+			assert.Equal(t, tt.wantSum, a.SHA256)
+			assert.Equal(t, int64(len(blobs[a.StorageKey])), a.Size)
+			assert.Contains(t, repo.stored, a.ID)
+		})
+	}
+}
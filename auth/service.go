@@ -19,6 +19,36 @@ func (s *Service) Register(ctx context.Context, req dto.RegisterRequest) error {
 	return s.kc.CreateUser(ctx, req.Name, req.Email, req.Password)
 }
 
-func (s *Service) Login(ctx context.Context, req dto.LoginRequest) (string, error) {
-	return s.kc.Login(ctx, req.Email, req.Password)
+func (s *Service) Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error) {
+	token, err := s.kc.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return toLoginResponse(token), nil
+}
+
+// Refresh returns new tokens for a refresh token. Keycloak rotates the
+// refresh token, so the one returned replaces it.
+func (s *Service) Refresh(ctx context.Context, req dto.RefreshTokenRequest) (*dto.LoginResponse, error) {
+	token, err := s.kc.Refresh(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, err
+	}
+	return toLoginResponse(token), nil
+}
+
+// Logout ends the session of the refresh token. Access tokens already issued
+// stay valid until they expire.
+func (s *Service) Logout(ctx context.Context, req dto.LogoutRequest) error {
+	return s.kc.Logout(ctx, req.RefreshToken)
+}
+
+func toLoginResponse(token *keycloak.Token) *dto.LoginResponse {
+	return &dto.LoginResponse{
+		AccessToken:      token.AccessToken,
+		ExpiresIn:        token.ExpiresIn,
+		RefreshToken:     token.RefreshToken,
+		RefreshExpiresIn: token.RefreshExpiresIn,
+		TokenType:        token.TokenType,
+	}
 }
@@ -0,0 +1,150 @@
+// Package authz decides what the caller of a request may do in a project.
+package authz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+
+	"github.com/google/uuid"
+)
+
+// MemberRepository looks up a user's membership of a project. It returns
+// domain.ErrNotFound when the user is not a member.
+type MemberRepository interface {
+	GetMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error)
+}
+
+var (
+	contributor = []domain.Permission{
+		domain.PermissionRead,
+		domain.PermissionCreate,
+		domain.PermissionEdit,
+		domain.PermissionAssign,
+		domain.PermissionComment,
+	}
+	manager = append(slices.Clone(contributor),
+		domain.PermissionDelete,
+		domain.PermissionManageMembers,
+		domain.PermissionManageProject,
+	)
+
+	// permissions is what each project role allows.
+	permissions = map[domain.ProjectRole][]domain.Permission{
+		domain.ProjectRoleOwner:  manager,
+		domain.ProjectRoleAdmin:  manager,
+		domain.ProjectRoleMember: contributor,
+		domain.ProjectRoleViewer: {domain.PermissionRead, domain.PermissionComment},
+	}
+)
+
+// Allows reports whether the role grants the permission.
+func Allows(role domain.ProjectRole, permission domain.Permission) bool {
+	return slices.Contains(permissions[role], permission)
+}
+
+// Unrestricted reports whether the caller passes every check: realm
+// administrators and the application itself.
+func Unrestricted(ctx context.Context) bool {
+	return authctx.IsSystem(ctx) || authctx.HasRole(ctx, authctx.RoleAdmin)
+}
+
+// RequireSelf returns domain.ErrForbidden unless the caller is userID or
+// unrestricted.
+func RequireSelf(ctx context.Context, userID uuid.UUID) error {
+	if Unrestricted(ctx) {
+		return nil
+	}
+	if callerID, ok := authctx.UserID(ctx); ok && callerID == userID {
+		return nil
+	}
+	return fmt.Errorf("%w: only available to the user themselves", domain.ErrForbidden)
+}
+
+// RequireRealmRole returns domain.ErrForbidden unless the caller holds the
+// Keycloak realm role or is unrestricted.
+func RequireRealmRole(ctx context.Context, role string) error {
+	if Unrestricted(ctx) || authctx.HasRole(ctx, role) {
+		return nil
+	}
+	return fmt.Errorf("%w: requires realm role %s", domain.ErrForbidden, role)
+}
+
+// RequireClientRole returns domain.ErrForbidden unless the caller holds the
+// role in the Keycloak client or is unrestricted.
+func RequireClientRole(ctx context.Context, clientID, role string) error {
+	if Unrestricted(ctx) || authctx.HasClientRole(ctx, clientID, role) {
+		return nil
+	}
+	return fmt.Errorf("%w: requires role %s of client %s", domain.ErrForbidden, role, clientID)
+}
+
+// Authorizer checks what the caller may do in a project. Services depend on
+// it rather than on RoleAuthorizer, so that tests can stand in for it.
+type Authorizer interface {
+	// Require returns domain.ErrForbidden unless the caller holds the
+	// permission in the project.
+	Require(ctx context.Context, projectID uuid.UUID, permission domain.Permission) error
+}
+
+// TaskGetter loads a task by ID.
+type TaskGetter interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+}
+
+// ReadTask loads a task once the caller is known to hold the permission in
+// its project.
+func ReadTask(
+	ctx context.Context,
+	authorizer Authorizer,
+	tasks TaskGetter,
+	id uuid.UUID,
+	permission domain.Permission,
+) (*domain.Task, error) {
+	task, err := tasks.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizer.Require(ctx, task.ProjectID, permission); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
+
+// RoleAuthorizer grants permissions by the caller's role in the project.
+type RoleAuthorizer struct {
+	members MemberRepository
+}
+
+func NewRoleAuthorizer(members MemberRepository) *RoleAuthorizer {
+	return &RoleAuthorizer{members: members}
+}
+
+// Require returns domain.ErrForbidden unless the caller's role in the project
+// grants the permission. Callers without a user are refused unless the
+// context was marked by authctx.AsSystem.
+func (a *RoleAuthorizer) Require(ctx context.Context, projectID uuid.UUID, permission domain.Permission) error {
+	if Unrestricted(ctx) {
+		return nil
+	}
+	userID, ok := authctx.UserID(ctx)
+	if !ok {
+		return fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+	}
+
+	member, err := a.members.GetMember(ctx, projectID, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("%w: not a member of the project", domain.ErrForbidden)
+	}
+	if err != nil {
+		return err
+	}
+	if !Allows(member.Role, permission) {
+		return fmt.Errorf("%w: role %s cannot %s", domain.ErrForbidden, member.Role, permission)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package authz_test
+
+import (
+	"context"
+	"testing"
+
+	"task-manager/authz"
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type memberships map[[2]uuid.UUID]domain.ProjectRole
+
+func (m memberships) GetMember(_ context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	role, ok := m[[2]uuid.UUID{projectID, userID}]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &domain.ProjectMember{ProjectID: projectID, UserID: userID, Role: role}, nil
+}
+
+func TestAllows(t *testing.T) {
+	all := []domain.Permission{
+		domain.PermissionRead,
+		domain.PermissionCreate,
+		domain.PermissionEdit,
+		domain.PermissionAssign,
+		domain.PermissionComment,
+		domain.PermissionDelete,
+		domain.PermissionManageMembers,
+		domain.PermissionManageProject,
+	}
+	allowed := map[domain.ProjectRole]int{
+		domain.ProjectRoleOwner:  8,
+		domain.ProjectRoleAdmin:  8,
+		domain.ProjectRoleMember: 5,
+		domain.ProjectRoleViewer: 2,
+		"guest":                  0,
+	}
+
+	for role, want := range allowed {
+		got := 0
+		for _, p := range all {
+			if authz.Allows(role, p) {
+				got++
+			}
+		}
+		assert.Equal(t, want, got, role)
+	}
+	assert.True(t, authz.Allows(domain.ProjectRoleViewer, domain.PermissionComment))
+	assert.False(t, authz.Allows(domain.ProjectRoleMember, domain.PermissionDelete))
+}
+
+func TestRoleAuthorizer_Require(t *testing.T) {
+	projectID, memberID, viewerID := uuid.New(), uuid.New(), uuid.New()
+	a := authz.NewRoleAuthorizer(memberships{
+		{projectID, memberID}: domain.ProjectRoleMember,
+		{projectID, viewerID}: domain.ProjectRoleViewer,
+	})
+	as := func(userID uuid.UUID) context.Context {
+		return authctx.WithUserID(context.Background(), userID)
+	}
+
+	assert.NoError(t, a.Require(as(memberID), projectID, domain.PermissionAssign))
+	assert.ErrorIs(t, a.Require(as(viewerID), projectID, domain.PermissionEdit), domain.ErrForbidden)
+	assert.ErrorIs(t, a.Require(as(memberID), uuid.New(), domain.PermissionRead), domain.ErrForbidden)
+	assert.ErrorIs(t, a.Require(context.Background(), projectID, domain.PermissionRead), domain.ErrForbidden)
+
+	admin := authctx.WithRoles(as(uuid.New()), []string{authctx.RoleAdmin})
+	assert.NoError(t, a.Require(admin, projectID, domain.PermissionManageMembers))
+	assert.NoError(t, a.Require(authctx.AsSystem(context.Background()), projectID, domain.PermissionDelete))
+}
+
+func TestRequireSelf(t *testing.T) {
+	userID := uuid.New()
+	ctx := authctx.WithUserID(context.Background(), userID)
+
+	assert.NoError(t, authz.RequireSelf(ctx, userID))
+	assert.ErrorIs(t, authz.RequireSelf(ctx, uuid.New()), domain.ErrForbidden)
+	assert.NoError(t, authz.RequireSelf(authctx.WithRoles(ctx, []string{authctx.RoleAdmin}), uuid.New()))
+}
+
+func TestRequireRoles(t *testing.T) {
+	ctx := authctx.WithPrincipal(context.Background(), authctx.Principal{
+		UserID:      uuid.New(),
+		RealmRoles:  []string{"reporter"},
+		ClientRoles: map[string][]string{"task-manager": {"auditor"}},
+	})
+
+	assert.NoError(t, authz.RequireRealmRole(ctx, "reporter"))
+	assert.ErrorIs(t, authz.RequireRealmRole(ctx, authctx.RoleAdmin), domain.ErrForbidden)
+	assert.NoError(t, authz.RequireClientRole(ctx, "task-manager", "auditor"))
+	assert.ErrorIs(t, authz.RequireClientRole(ctx, "account", "auditor"), domain.ErrForbidden)
+	assert.NoError(t, authz.RequireClientRole(authctx.AsSystem(context.Background()), "account", "auditor"))
+}
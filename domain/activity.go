@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ActivityAction string
+
+const (
+	ActivityCreated        ActivityAction = "created"
+	ActivityUpdated        ActivityAction = "updated"
+	ActivityAssigned       ActivityAction = "assigned"
+	ActivityCommented      ActivityAction = "commented"
+	ActivityCommentEdited  ActivityAction = "comment_edited"
+	ActivityCommentDeleted ActivityAction = "comment_deleted"
+	ActivityDeleted        ActivityAction = "deleted"
+	ActivityRestored       ActivityAction = "restored"
+)
+
+// FieldChange records the value of a task field before and after a change.
+// Values are rendered as strings; empty means unset.
+type FieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// Activity is an entry in the history of a task.
+type Activity struct {
+	ID        uuid.UUID
+	TaskID    uuid.UUID
+	ActorID   uuid.UUID
+	Action    ActivityAction
+	Changes   []FieldChange
+	CreatedAt time.Time
+}
@@ -0,0 +1,24 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Attachment describes a file uploaded to a task, or to one of its comments.
+// The content itself lives in the blob store under StorageKey.
+type Attachment struct {
+	ID     uuid.UUID
+	TaskID uuid.UUID
+	// CommentID is set when the file was attached to a comment.
+	CommentID   *uuid.UUID
+	UploaderID  uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	// SHA256 is the hex-encoded checksum of the content.
+	SHA256     string
+	StorageKey string
+	CreatedAt  time.Time
+}
@@ -7,9 +7,13 @@ import (
 )
 
 type Comment struct {
-	ID        uuid.UUID
-	TaskID    uuid.UUID
+	ID     uuid.UUID
+	TaskID uuid.UUID
+	// ParentID is the comment this one replies to, nil for top-level comments.
+	ParentID  *uuid.UUID
 	UserID    uuid.UUID
 	Content   string
 	CreatedAt time.Time
+	// EditedAt is set once the author changes the content.
+	EditedAt *time.Time
 }
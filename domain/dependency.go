@@ -0,0 +1,7 @@
+package domain
+
+// TaskDependencies lists the tasks a task waits on and the tasks waiting on it.
+type TaskDependencies struct {
+	BlockedBy []Task
+	Blocks    []Task
+}
@@ -0,0 +1,59 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrNotFound is returned when a requested entity does not exist.
+	ErrNotFound = errors.New("not found")
+	// ErrConflict is returned when an entity clashes with an existing one.
+	ErrConflict = errors.New("conflict")
+	// ErrForbidden is returned when the caller may not act on an entity.
+	ErrForbidden = errors.New("forbidden")
+	// ErrVersionConflict is returned when an entity was modified since the caller read it.
+	ErrVersionConflict = errors.New("version conflict")
+	// ErrInvalidWorkflow is returned when a workflow definition is inconsistent.
+	ErrInvalidWorkflow = errors.New("invalid workflow")
+	// ErrInvalidTask is returned when task fields fail validation.
+	ErrInvalidTask = errors.New("invalid task")
+	// ErrInvalidProject is returned when project fields or a membership change fail validation.
+	ErrInvalidProject = errors.New("invalid project")
+	// ErrInvalidLabel is returned when label fields fail validation.
+	ErrInvalidLabel = errors.New("invalid label")
+	// ErrInvalidComment is returned when comment fields fail validation.
+	ErrInvalidComment = errors.New("invalid comment")
+	// ErrInvalidAttachment is returned when an upload is empty or its metadata is invalid.
+	ErrInvalidAttachment = errors.New("invalid attachment")
+	// ErrAttachmentTooLarge is returned when an upload exceeds the size limit.
+	ErrAttachmentTooLarge = errors.New("attachment too large")
+	// ErrUnsupportedMediaType is returned when an upload's content type is not allowed.
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+	// ErrInvalidRecurrence is returned when a recurrence rule is malformed or cannot apply to a task.
+	ErrInvalidRecurrence = errors.New("invalid recurrence")
+	// ErrInvalidPreferences is returned when notification preferences fail validation.
+	ErrInvalidPreferences = errors.New("invalid notification preferences")
+	// ErrInvalidWebhook is returned when a webhook subscription fails validation.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrInvalidQuery is returned when a search query is empty or malformed.
+	ErrInvalidQuery = errors.New("invalid search query")
+	// ErrHasSubtasks is returned when deleting a task that still has subtasks.
+	ErrHasSubtasks = errors.New("task has subtasks")
+	// ErrDependencyCycle is returned when a dependency would make tasks wait on each other.
+	ErrDependencyCycle = errors.New("dependency would create a cycle")
+)
+
+// TransitionError reports a task status that the project's workflow does not allow.
+type TransitionError struct {
+	From   string
+	To     string
+	Reason string
+}
+
+func (e *TransitionError) Error() string {
+	if e.From == "" {
+		return fmt.Sprintf("status %q is not allowed: %s", e.To, e.Reason)
+	}
+	return fmt.Sprintf("cannot move task from %q to %q: %s", e.From, e.To, e.Reason)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskEventType names something that happened to a task.
+type TaskEventType string
+
+const (
+	TaskCreated    TaskEventType = "task.created"
+	TaskUpdated    TaskEventType = "task.updated"
+	TaskAssigned   TaskEventType = "task.assigned"
+	TaskCommented  TaskEventType = "task.commented"
+	CommentEdited  TaskEventType = "comment.edited"
+	CommentDeleted TaskEventType = "comment.deleted"
+	TaskDeleted    TaskEventType = "task.deleted"
+)
+
+var TaskEventTypes = []TaskEventType{
+	TaskCreated, TaskUpdated, TaskAssigned, TaskCommented, CommentEdited, CommentDeleted, TaskDeleted,
+}
+
+func (t TaskEventType) Valid() bool {
+	for _, known := range TaskEventTypes {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// TaskEvent describes a change to a task for consumers outside the service,
+// such as webhook subscribers.
+type TaskEvent struct {
+	ID uuid.UUID
+	// Sequence is the event's position in the outbox, set once it is stored.
+	// Later events of a task have higher sequences.
+	Sequence  int64
+	Type      TaskEventType
+	ProjectID uuid.UUID
+	// ActorID is who made the change, uuid.Nil for the system.
+	ActorID    uuid.UUID
+	OccurredAt time.Time
+	// Task is the task after the change, or before it for TaskDeleted.
+	Task Task
+	// Comment is set for TaskCommented, CommentEdited and CommentDeleted.
+	Comment *Comment
+	// Changes lists the fields a TaskUpdated or TaskAssigned event changed.
+	Changes []FieldChange
+	// Mentions lists the users newly mentioned by the change.
+	Mentions []Mention
+}
+
+// OutboxEvent is a task event waiting in the outbox to be relayed to its
+// subscribers. Sequence orders the events of a task.
+type OutboxEvent struct {
+	Sequence    int64
+	Event       TaskEvent
+	Attempts    int
+	AvailableAt time.Time
+	LastError   string
+	CreatedAt   time.Time
+}
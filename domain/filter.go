@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TaskFilter narrows task list queries. Zero-valued fields do not filter.
+type TaskFilter struct {
+	// LabelIDs keeps tasks carrying any of the labels, or all of them when MatchAllLabels is set.
+	LabelIDs       []uuid.UUID
+	MatchAllLabels bool
+	// Statuses keeps tasks in any of the given statuses.
+	Statuses   []string
+	AssigneeID *uuid.UUID
+	// Text keeps tasks whose title or description contains it, ignoring case.
+	Text string
+	// CreatedFrom and CreatedTo bound the creation time to [CreatedFrom, CreatedTo).
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+}
+
+type TaskSortField string
+
+const (
+	TaskSortCreatedAt TaskSortField = "created_at"
+	TaskSortUpdatedAt TaskSortField = "updated_at"
+	TaskSortDueDate   TaskSortField = "due_date"
+	TaskSortPriority  TaskSortField = "priority"
+)
+
+func (f TaskSortField) Valid() bool {
+	switch f {
+	case TaskSortCreatedAt, TaskSortUpdatedAt, TaskSortDueDate, TaskSortPriority:
+		return true
+	}
+	return false
+}
+
+// TaskSort orders task lists. Ties are broken by task ID, and tasks without
+// a due date come last when sorting by due date in either direction.
+type TaskSort struct {
+	Field TaskSortField
+	Desc  bool
+}
+
+// DefaultTaskSort lists the newest tasks first.
+var DefaultTaskSort = TaskSort{Field: TaskSortCreatedAt, Desc: true}
@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Label struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	Name      string
+	Color     string
+	CreatedAt time.Time
+}
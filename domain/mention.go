@@ -0,0 +1,18 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Mention records that a user was @mentioned in a task description or comment.
+type Mention struct {
+	ID     uuid.UUID
+	UserID uuid.UUID
+	TaskID uuid.UUID
+	// CommentID is nil for mentions in the task description.
+	CommentID *uuid.UUID
+	AuthorID  uuid.UUID
+	CreatedAt time.Time
+}
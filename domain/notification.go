@@ -0,0 +1,121 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationEvent is what a notification is about.
+type NotificationEvent string
+
+const (
+	EventAssigned      NotificationEvent = "assigned"
+	EventMentioned     NotificationEvent = "mentioned"
+	EventStatusChanged NotificationEvent = "status_changed"
+	EventDueSoon       NotificationEvent = "due_soon"
+)
+
+var NotificationEvents = []NotificationEvent{EventAssigned, EventMentioned, EventStatusChanged, EventDueSoon}
+
+func (e NotificationEvent) Valid() bool {
+	for _, known := range NotificationEvents {
+		if e == known {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationChannel is a medium notifications are delivered over.
+type NotificationChannel string
+
+const (
+	ChannelInApp   NotificationChannel = "in_app"
+	ChannelEmail   NotificationChannel = "email"
+	ChannelWebhook NotificationChannel = "webhook"
+)
+
+func (c NotificationChannel) Valid() bool {
+	switch c {
+	case ChannelInApp, ChannelEmail, ChannelWebhook:
+		return true
+	}
+	return false
+}
+
+// Notification tells one user about an event on a task.
+type Notification struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Event     NotificationEvent
+	TaskID    uuid.UUID
+	CommentID *uuid.UUID
+	// ActorID is who caused the event, uuid.Nil for the system.
+	ActorID   uuid.UUID
+	Subject   string
+	Body      string
+	CreatedAt time.Time
+	// ReadAt is set once the user has read the notification in their inbox.
+	ReadAt *time.Time
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryPending DeliveryStatus = "pending"
+	DeliverySent    DeliveryStatus = "sent"
+	DeliveryFailed  DeliveryStatus = "failed"
+)
+
+// Delivery is the sending of a notification over one channel. Pending
+// deliveries are attempted at NextAttemptAt.
+type Delivery struct {
+	ID             uuid.UUID
+	NotificationID uuid.UUID
+	Channel        NotificationChannel
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	// Notification is loaded along with deliveries that are due.
+	Notification *Notification
+}
+
+// DeliveryAttempt logs one try at a delivery.
+type DeliveryAttempt struct {
+	ID          uuid.UUID
+	DeliveryID  uuid.UUID
+	Attempt     int
+	Succeeded   bool
+	Error       string
+	AttemptedAt time.Time
+}
+
+// NotificationPreferences say how a user wants to be notified.
+type NotificationPreferences struct {
+	UserID uuid.UUID
+	// Channels lists the channels each event is delivered on. Events that
+	// are not listed go to the in-app inbox only; an empty list mutes one.
+	Channels map[NotificationEvent][]NotificationChannel
+	// Email and WebhookURL are where the email and webhook channels deliver.
+	Email      string
+	WebhookURL string
+	// QuietHoursStart and QuietHoursEnd are "15:04" wall-clock times in
+	// Timezone. Email and webhook deliveries falling between them wait until
+	// the quiet hours end. Empty means no quiet hours.
+	QuietHoursStart string
+	QuietHoursEnd   string
+	Timezone        string
+	UpdatedAt       time.Time
+}
+
+// ChannelsFor returns the channels an event is delivered on.
+func (p NotificationPreferences) ChannelsFor(event NotificationEvent) []NotificationChannel {
+	if channels, ok := p.Channels[event]; ok {
+		return channels
+	}
+	return []NotificationChannel{ChannelInApp}
+}
@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Project struct {
+	ID   uuid.UUID
+	Name string
+	// Key is a short unique code such as "WEB", in upper case.
+	Key         string
+	Description string
+	OwnerID     uuid.UUID
+	// Archived projects are left out of project lists unless asked for.
+	Archived  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// ProjectRole is what a member may do in a project.
+type ProjectRole string
+
+const (
+	ProjectRoleOwner  ProjectRole = "owner"
+	ProjectRoleAdmin  ProjectRole = "admin"
+	ProjectRoleMember ProjectRole = "member"
+	ProjectRoleViewer ProjectRole = "viewer"
+)
+
+var ProjectRoles = []ProjectRole{ProjectRoleOwner, ProjectRoleAdmin, ProjectRoleMember, ProjectRoleViewer}
+
+func (r ProjectRole) Valid() bool {
+	for _, known := range ProjectRoles {
+		if r == known {
+			return true
+		}
+	}
+	return false
+}
+
+type ProjectMember struct {
+	ProjectID uuid.UUID
+	UserID    uuid.UUID
+	Role      ProjectRole
+	CreatedAt time.Time
+}
+
+// Permission is something a project role may allow its members to do.
+type Permission string
+
+const (
+	PermissionRead          Permission = "read"
+	PermissionCreate        Permission = "create"
+	PermissionEdit          Permission = "edit"
+	PermissionAssign        Permission = "assign"
+	PermissionComment       Permission = "comment"
+	PermissionDelete        Permission = "delete"
+	PermissionManageMembers Permission = "manage_members"
+	// PermissionManageProject covers the project itself and its settings:
+	// workflow, labels and webhooks.
+	PermissionManageProject Permission = "manage_project"
+)
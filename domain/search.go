@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"github.com/google/uuid"
+)
+
+// SearchKind tells what a search hit matched.
+type SearchKind string
+
+const (
+	SearchKindTask    SearchKind = "task"
+	SearchKindComment SearchKind = "comment"
+)
+
+// SearchQuery is a full-text query over tasks and their comments. Zero-valued
+// filters do not narrow the results.
+type SearchQuery struct {
+	// Text uses web search syntax: quoted phrases, "or" and -excluded words.
+	Text       string
+	ProjectID  *uuid.UUID
+	AssigneeID *uuid.UUID
+	Statuses   []string
+	// MemberID limits hits to the projects the user is a member of.
+	MemberID *uuid.UUID
+}
+
+// SearchHit is one matching task or comment, with a snippet whose matched
+// terms are wrapped in <mark></mark>. The snippet is safe HTML: the rest of
+// the text is escaped. The title is plain text.
+type SearchHit struct {
+	Kind      SearchKind
+	TaskID    uuid.UUID
+	CommentID *uuid.UUID
+	ProjectID uuid.UUID
+	Title     string
+	Snippet   string
+	Rank      float32
+}
@@ -0,0 +1,41 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Series is a recurring task. Its occurrences are tasks linked to it; new
+// occurrences are created from the template fields of the series.
+type Series struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	// RRule is the recurrence rule in RFC 5545 form, such as "FREQ=WEEKLY;BYDAY=MO".
+	RRule string
+	// Timezone is the IANA zone the rule is expanded in.
+	Timezone string
+	// Start is the start of the first occurrence, from which the rule is expanded.
+	Start       time.Time
+	Title       string
+	Description string
+	Priority    Priority
+	AssignedTo  uuid.UUID
+	// DueAfter is the time from the start of an occurrence to its due date,
+	// or nil when occurrences have no due date.
+	DueAfter *time.Duration
+	// CurrentTaskID and LastAt identify the latest occurrence.
+	CurrentTaskID uuid.UUID
+	LastAt        time.Time
+	// NextAt is the start of the next occurrence, nil once the rule is exhausted.
+	NextAt    *time.Time
+	StoppedAt *time.Time
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Active reports whether the series will create more occurrences.
+func (s Series) Active() bool {
+	return s.StoppedAt == nil && s.NextAt != nil
+}
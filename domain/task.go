@@ -6,13 +6,50 @@ import (
 	"github.com/google/uuid"
 )
 
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+	PriorityUrgent Priority = "urgent"
+)
+
+func (p Priority) Valid() bool {
+	switch p {
+	case PriorityLow, PriorityMedium, PriorityHigh, PriorityUrgent:
+		return true
+	}
+	return false
+}
+
+// SubtaskRollup summarizes the progress of a task's direct subtasks.
+type SubtaskRollup struct {
+	Total int
+	Done  int
+}
+
 type Task struct {
 	ID          uuid.UUID
 	Title       string
 	Description string
 	Status      string
+	Priority    Priority
 	AssignedTo  uuid.UUID
 	ProjectID   uuid.UUID
+	ParentID    *uuid.UUID
+	// SeriesID links an occurrence of a recurring task to its series.
+	SeriesID    *uuid.UUID
+	Subtasks    SubtaskRollup
+	Labels      []Label
+	StartDate   *time.Time
+	DueDate     *time.Time
+	CompletedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	// Version is incremented on every write and used for optimistic concurrency.
+	Version int64
+	// DeletedAt and DeletedBy are set while the task is in the trash.
+	DeletedAt *time.Time
+	DeletedBy *uuid.UUID
 }
@@ -0,0 +1,61 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook subscribes a URL to the task events of a project. Deliveries are
+// signed with Secret.
+type Webhook struct {
+	ID        uuid.UUID
+	ProjectID uuid.UUID
+	URL       string
+	Secret    string
+	Events    []TaskEventType
+	Active    bool
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Subscribes reports whether the webhook wants events of type t.
+func (w Webhook) Subscribes(t TaskEventType) bool {
+	for _, e := range w.Events {
+		if e == t {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is the sending of one event to one webhook. Payload holds
+// the exact body that is signed and sent, so that replays are identical.
+type WebhookDelivery struct {
+	ID            uuid.UUID
+	WebhookID     uuid.UUID
+	EventID       uuid.UUID
+	EventType     TaskEventType
+	Payload       string
+	Status        DeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// ReplayOf is the delivery this one replays.
+	ReplayOf  *uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// WebhookAttempt logs one try at a webhook delivery. StatusCode is 0 when no
+// response was received.
+type WebhookAttempt struct {
+	ID          uuid.UUID
+	DeliveryID  uuid.UUID
+	Attempt     int
+	StatusCode  int
+	Error       string
+	Duration    time.Duration
+	AttemptedAt time.Time
+}
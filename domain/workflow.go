@@ -0,0 +1,30 @@
+package domain
+
+import "github.com/google/uuid"
+
+// StatusCategory groups workflow statuses so that other features can reason
+// about progress without knowing each project's status names.
+type StatusCategory string
+
+const (
+	StatusCategoryTodo       StatusCategory = "todo"
+	StatusCategoryInProgress StatusCategory = "in_progress"
+	StatusCategoryDone       StatusCategory = "done"
+)
+
+type WorkflowStatus struct {
+	Name     string
+	Category StatusCategory
+}
+
+type WorkflowTransition struct {
+	From string
+	To   string
+}
+
+type Workflow struct {
+	ProjectID     uuid.UUID
+	InitialStatus string
+	Statuses      []WorkflowStatus
+	Transitions   []WorkflowTransition
+}
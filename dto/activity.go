@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type FieldChange struct {
+	Field string `json:"field" example:"status"`
+	From  string `json:"from" example:"open"`
+	To    string `json:"to" example:"in_progress"`
+}
+
+type ActivityResponse struct {
+	ID        uuid.UUID     `json:"id" example:"6b1f3c2e-9d4a-4e8b-a7c5-1f2e3d4c5b6a"`
+	TaskID    uuid.UUID     `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	ActorID   uuid.UUID     `json:"actor_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	Action    string        `json:"action" example:"updated"`
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"created_at" example:"2025-03-13T11:30:00Z"`
+}
+
+// ActivityPageResponse is one page of a task's history, newest first.
+// @Description Paginated task activity
+type ActivityPageResponse struct {
+	Items      []ActivityResponse `json:"items"`
+	NextCursor string             `json:"next_cursor,omitempty" example:"eyJ0IjoiMjAyNS0wMy0xM1QxMTozMDowMFoiLCJpZCI6IjZiMWYzYzJlIn0"`
+}
+
+func NewActivityResponse(a domain.Activity) ActivityResponse {
+	changes := make([]FieldChange, 0, len(a.Changes))
+	for _, c := range a.Changes {
+		changes = append(changes, FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+
+	return ActivityResponse{
+		ID:        a.ID,
+		TaskID:    a.TaskID,
+		ActorID:   a.ActorID,
+		Action:    string(a.Action),
+		Changes:   changes,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func NewActivityPageResponse(activities []domain.Activity, nextCursor string) ActivityPageResponse {
+	items := make([]ActivityResponse, 0, len(activities))
+	for _, a := range activities {
+		items = append(items, NewActivityResponse(a))
+	}
+	return ActivityPageResponse{Items: items, NextCursor: nextCursor}
+}
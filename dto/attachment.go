@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type AttachmentResponse struct {
+	ID          uuid.UUID  `json:"id" example:"9b2d5c1e-3f4a-4b6c-8d7e-1a2b3c4d5e6f"`
+	TaskID      uuid.UUID  `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	CommentID   *uuid.UUID `json:"comment_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	UploaderID  uuid.UUID  `json:"uploader_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	FileName    string     `json:"file_name" example:"screenshot.png"`
+	ContentType string     `json:"content_type" example:"image/png"`
+	Size        int64      `json:"size" example:"48213"`
+	SHA256      string     `json:"sha256" example:"9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"`
+	CreatedAt   time.Time  `json:"created_at" example:"2025-03-13T11:30:00Z"`
+}
+
+func NewAttachmentResponse(a domain.Attachment) AttachmentResponse {
+	return AttachmentResponse{
+		ID:          a.ID,
+		TaskID:      a.TaskID,
+		CommentID:   a.CommentID,
+		UploaderID:  a.UploaderID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		SHA256:      a.SHA256,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func NewAttachmentListResponse(attachments []domain.Attachment) []AttachmentResponse {
+	items := make([]AttachmentResponse, 0, len(attachments))
+	for _, a := range attachments {
+		items = append(items, NewAttachmentResponse(a))
+	}
+	return items
+}
@@ -15,8 +15,26 @@ type LoginRequest struct {
 	Password string `json:"password" binding:"required" example:"demo123"`
 }
 
-// LoginResponse contains the JWT token returned upon successful login.
-// @Description Login response containing JWT access token
+// LoginResponse contains the tokens returned upon successful login or token
+// refresh. The expiries are in seconds.
+// @Description Login response containing the JWT access token and the refresh token
 type LoginResponse struct {
-	AccessToken string `json:"access_token" example:"eyJhbGciOi..."`
+	AccessToken      string `json:"access_token" example:"eyJhbGciOi..."`
+	ExpiresIn        int64  `json:"expires_in" example:"300"`
+	RefreshToken     string `json:"refresh_token" example:"eyJhbGciOi..."`
+	RefreshExpiresIn int64  `json:"refresh_expires_in" example:"1800"`
+	TokenType        string `json:"token_type" example:"Bearer"`
+}
+
+// RefreshTokenRequest is the payload for exchanging a refresh token for new
+// tokens.
+// @Description Token refresh request
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"eyJhbGciOi..."`
+}
+
+// LogoutRequest is the payload for ending the session of a refresh token.
+// @Description Logout request
+type LogoutRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required" example:"eyJhbGciOi..."`
 }
@@ -1,7 +1,59 @@
 package dto
 
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
 // CommentRequest is the payload for adding a comment to a task.
 // @Description Comment creation request
 type CommentRequest struct {
-	Content string `json:"content" binding:"required" example:"Great job!"`
+	Content  string     `json:"content" binding:"required" example:"Great job!"`
+	ParentID *uuid.UUID `json:"parent_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+}
+
+// EditCommentRequest is the payload for changing a comment.
+// @Description Comment edit request
+type EditCommentRequest struct {
+	Content string `json:"content" binding:"required" example:"Great job, thanks!"`
+}
+
+type CommentResponse struct {
+	ID        uuid.UUID  `json:"id" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	TaskID    uuid.UUID  `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	ParentID  *uuid.UUID `json:"parent_id,omitempty" example:"1b4e28ba-2fa1-11d2-883f-0016d3cca427"`
+	UserID    uuid.UUID  `json:"user_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	Content   string     `json:"content" example:"Great job!"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-03-13T11:30:00Z"`
+	EditedAt  *time.Time `json:"edited_at,omitempty" example:"2025-03-13T11:45:00Z"`
+}
+
+// CommentPageResponse is one page of a task's comments, oldest first.
+// @Description Paginated task comments
+type CommentPageResponse struct {
+	Items      []CommentResponse `json:"items"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJrIjoiMjAyNS0wMy0xM1QxMTozMDowMFoiLCJpZCI6IjdjOWU2Njc5In0"`
+}
+
+func NewCommentResponse(c domain.Comment) CommentResponse {
+	return CommentResponse{
+		ID:        c.ID,
+		TaskID:    c.TaskID,
+		ParentID:  c.ParentID,
+		UserID:    c.UserID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+		EditedAt:  c.EditedAt,
+	}
+}
+
+func NewCommentPageResponse(comments []domain.Comment, nextCursor string) CommentPageResponse {
+	items := make([]CommentResponse, 0, len(comments))
+	for _, c := range comments {
+		items = append(items, NewCommentResponse(c))
+	}
+	return CommentPageResponse{Items: items, NextCursor: nextCursor}
 }
@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// AddDependencyRequest marks a task as blocked by another task.
+// @Description Task dependency creation request
+type AddDependencyRequest struct {
+	BlockerID uuid.UUID `json:"blocker_id" binding:"required" example:"9b1deb4d-3b7d-4bad-9bdd-2b0d7b3dcb6d"`
+}
+
+// DependenciesResponse lists the tasks a task waits on and the tasks waiting on it.
+// @Description Task dependencies
+type DependenciesResponse struct {
+	BlockedBy []TaskResponse `json:"blocked_by"`
+	Blocks    []TaskResponse `json:"blocks"`
+}
+
+func NewDependenciesResponse(deps domain.TaskDependencies) DependenciesResponse {
+	return DependenciesResponse{
+		BlockedBy: NewTaskResponseList(deps.BlockedBy),
+		Blocks:    NewTaskResponseList(deps.Blocks),
+	}
+}
@@ -0,0 +1,43 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// TaskEventResponse is a change to a task pushed to live clients.
+// @Description Live task event
+type TaskEventResponse struct {
+	ID         uuid.UUID        `json:"id" example:"9b2d4f6a-1c3e-4a5b-8d7f-0e1a2b3c4d5e"`
+	Sequence   int64            `json:"sequence" example:"4182"`
+	Type       string           `json:"type" example:"task.updated"`
+	ProjectID  uuid.UUID        `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	ActorID    uuid.UUID        `json:"actor_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	OccurredAt time.Time        `json:"occurred_at" example:"2025-03-13T11:30:00Z"`
+	Task       TaskResponse     `json:"task"`
+	Comment    *CommentResponse `json:"comment,omitempty"`
+	Changes    []FieldChange    `json:"changes,omitempty"`
+}
+
+func NewTaskEventResponse(e domain.TaskEvent) TaskEventResponse {
+	res := TaskEventResponse{
+		ID:         e.ID,
+		Sequence:   e.Sequence,
+		Type:       string(e.Type),
+		ProjectID:  e.ProjectID,
+		ActorID:    e.ActorID,
+		OccurredAt: e.OccurredAt,
+		Task:       NewTaskResponse(e.Task),
+	}
+	if e.Comment != nil {
+		comment := NewCommentResponse(*e.Comment)
+		res.Comment = &comment
+	}
+	for _, c := range e.Changes {
+		res.Changes = append(res.Changes, FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	return res
+}
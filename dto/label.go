@@ -0,0 +1,55 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// CreateLabelRequest defines a new label in a project.
+// @Description Label creation request
+type CreateLabelRequest struct {
+	Name  string `json:"name" binding:"required" example:"bug"`
+	Color string `json:"color" binding:"required,hexcolor" example:"#d73a4a"`
+}
+
+// UpdateLabelRequest renames or recolors a label. Omitted fields are left unchanged.
+// @Description Label update request
+type UpdateLabelRequest struct {
+	Name  *string `json:"name,omitempty" example:"defect"`
+	Color *string `json:"color,omitempty" binding:"omitempty,hexcolor" example:"#b60205"`
+}
+
+// AttachLabelRequest attaches an existing label to a task.
+// @Description Task label attachment request
+type AttachLabelRequest struct {
+	LabelID uuid.UUID `json:"label_id" binding:"required" example:"0e2f7c59-6a3b-4d8e-9f1a-2b4c6d8e0f13"`
+}
+
+type LabelResponse struct {
+	ID        uuid.UUID `json:"id" example:"0e2f7c59-6a3b-4d8e-9f1a-2b4c6d8e0f13"`
+	ProjectID uuid.UUID `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	Name      string    `json:"name" example:"bug"`
+	Color     string    `json:"color" example:"#d73a4a"`
+	CreatedAt time.Time `json:"created_at" example:"2025-03-13T10:00:00Z"`
+}
+
+func NewLabelResponse(l domain.Label) LabelResponse {
+	return LabelResponse{
+		ID:        l.ID,
+		ProjectID: l.ProjectID,
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+func NewLabelResponseList(labels []domain.Label) []LabelResponse {
+	res := make([]LabelResponse, 0, len(labels))
+	for _, l := range labels {
+		res = append(res, NewLabelResponse(l))
+	}
+	return res
+}
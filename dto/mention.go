@@ -0,0 +1,38 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type MentionResponse struct {
+	ID        uuid.UUID  `json:"id" example:"9b2d1f3e-4c5a-4e6b-8d7c-0a1b2c3d4e5f"`
+	TaskID    uuid.UUID  `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	CommentID *uuid.UUID `json:"comment_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	AuthorID  uuid.UUID  `json:"author_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-03-13T11:30:00Z"`
+}
+
+// MentionPageResponse is one page of a user's mentions, newest first.
+// @Description Paginated mentions
+type MentionPageResponse struct {
+	Items      []MentionResponse `json:"items"`
+	NextCursor string            `json:"next_cursor,omitempty" example:"eyJrIjoiMjAyNS0wMy0xM1QxMTozMDowMFoiLCJpZCI6IjliMmQxZjNlIn0"`
+}
+
+func NewMentionPageResponse(mentions []domain.Mention, nextCursor string) MentionPageResponse {
+	items := make([]MentionResponse, 0, len(mentions))
+	for _, m := range mentions {
+		items = append(items, MentionResponse{
+			ID:        m.ID,
+			TaskID:    m.TaskID,
+			CommentID: m.CommentID,
+			AuthorID:  m.AuthorID,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return MentionPageResponse{Items: items, NextCursor: nextCursor}
+}
@@ -0,0 +1,117 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type NotificationResponse struct {
+	ID        uuid.UUID  `json:"id" example:"9b2d1f3e-4c5a-4e6b-8d7c-0a1b2c3d4e5f"`
+	Event     string     `json:"event" example:"assigned" enums:"assigned,mentioned,status_changed,due_soon"`
+	TaskID    uuid.UUID  `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	CommentID *uuid.UUID `json:"comment_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	ActorID   *uuid.UUID `json:"actor_id,omitempty" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	Subject   string     `json:"subject" example:"You were assigned to \"Write docs\""`
+	Body      string     `json:"body" example:"Document the notification API"`
+	CreatedAt time.Time  `json:"created_at" example:"2025-03-13T11:30:00Z"`
+	ReadAt    *time.Time `json:"read_at,omitempty" example:"2025-03-13T12:00:00Z"`
+}
+
+// NotificationPageResponse is one page of a user's inbox, newest first.
+// @Description Paginated notifications
+type NotificationPageResponse struct {
+	Items      []NotificationResponse `json:"items"`
+	NextCursor string                 `json:"next_cursor,omitempty" example:"eyJrIjoiMjAyNS0wMy0xM1QxMTozMDowMFoiLCJpZCI6IjliMmQxZjNlIn0"`
+}
+
+func NewNotificationPageResponse(notifications []domain.Notification, nextCursor string) NotificationPageResponse {
+	items := make([]NotificationResponse, 0, len(notifications))
+	for _, n := range notifications {
+		item := NotificationResponse{
+			ID:        n.ID,
+			Event:     string(n.Event),
+			TaskID:    n.TaskID,
+			CommentID: n.CommentID,
+			Subject:   n.Subject,
+			Body:      n.Body,
+			CreatedAt: n.CreatedAt,
+			ReadAt:    n.ReadAt,
+		}
+		if n.ActorID != uuid.Nil {
+			actorID := n.ActorID
+			item.ActorID = &actorID
+		}
+		items = append(items, item)
+	}
+	return NotificationPageResponse{Items: items, NextCursor: nextCursor}
+}
+
+// MarkNotificationsReadRequest lists the notifications to mark read. An
+// empty list marks the whole inbox read.
+type MarkNotificationsReadRequest struct {
+	IDs []uuid.UUID `json:"ids" example:"9b2d1f3e-4c5a-4e6b-8d7c-0a1b2c3d4e5f"`
+}
+
+// NotificationPreferencesRequest replaces a user's notification preferences.
+type NotificationPreferencesRequest struct {
+	// Channels per event; events left out go to the in-app inbox only, and
+	// an empty list mutes an event.
+	Channels        map[string][]string `json:"channels"`
+	Email           string              `json:"email" example:"alice@example.com"`
+	WebhookURL      string              `json:"webhook_url" example:"https://hooks.example.com/tasks"`
+	QuietHoursStart string              `json:"quiet_hours_start" example:"22:00"`
+	QuietHoursEnd   string              `json:"quiet_hours_end" example:"07:00"`
+	Timezone        string              `json:"timezone" example:"Europe/Berlin"`
+}
+
+func (r NotificationPreferencesRequest) ToDomain(userID uuid.UUID) domain.NotificationPreferences {
+	channels := make(map[domain.NotificationEvent][]domain.NotificationChannel, len(r.Channels))
+	for event, names := range r.Channels {
+		chs := make([]domain.NotificationChannel, 0, len(names))
+		for _, name := range names {
+			chs = append(chs, domain.NotificationChannel(name))
+		}
+		channels[domain.NotificationEvent(event)] = chs
+	}
+	return domain.NotificationPreferences{
+		UserID:          userID,
+		Channels:        channels,
+		Email:           r.Email,
+		WebhookURL:      r.WebhookURL,
+		QuietHoursStart: r.QuietHoursStart,
+		QuietHoursEnd:   r.QuietHoursEnd,
+		Timezone:        r.Timezone,
+	}
+}
+
+type NotificationPreferencesResponse struct {
+	// Channels per event, with defaults filled in for events left unset.
+	Channels        map[string][]string `json:"channels"`
+	Email           string              `json:"email,omitempty" example:"alice@example.com"`
+	WebhookURL      string              `json:"webhook_url,omitempty" example:"https://hooks.example.com/tasks"`
+	QuietHoursStart string              `json:"quiet_hours_start,omitempty" example:"22:00"`
+	QuietHoursEnd   string              `json:"quiet_hours_end,omitempty" example:"07:00"`
+	Timezone        string              `json:"timezone" example:"Europe/Berlin"`
+}
+
+func NewNotificationPreferencesResponse(p domain.NotificationPreferences) NotificationPreferencesResponse {
+	channels := make(map[string][]string, len(domain.NotificationEvents))
+	for _, event := range domain.NotificationEvents {
+		names := []string{}
+		for _, ch := range p.ChannelsFor(event) {
+			names = append(names, string(ch))
+		}
+		channels[string(event)] = names
+	}
+	return NotificationPreferencesResponse{
+		Channels:        channels,
+		Email:           p.Email,
+		WebhookURL:      p.WebhookURL,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+	}
+}
@@ -0,0 +1,90 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// CreateProjectRequest creates a project owned by the caller.
+// @Description Project creation request
+type CreateProjectRequest struct {
+	Name string `json:"name" binding:"required" example:"Website"`
+	// Key is 2-10 letters or digits, starting with a letter; stored in upper case.
+	Key         string `json:"key" binding:"required" example:"WEB"`
+	Description string `json:"description,omitempty" example:"Marketing site relaunch"`
+}
+
+// UpdateProjectRequest changes a project. Omitted fields are left unchanged.
+// @Description Project update request
+type UpdateProjectRequest struct {
+	Name        *string `json:"name,omitempty" example:"Website 2.0"`
+	Description *string `json:"description,omitempty" example:"Marketing site relaunch"`
+	Archived    *bool   `json:"archived,omitempty" example:"true"`
+}
+
+type ProjectResponse struct {
+	ID          uuid.UUID `json:"id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	Name        string    `json:"name" example:"Website"`
+	Key         string    `json:"key" example:"WEB"`
+	Description string    `json:"description" example:"Marketing site relaunch"`
+	OwnerID     uuid.UUID `json:"owner_id" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	Archived    bool      `json:"archived" example:"false"`
+	CreatedAt   time.Time `json:"created_at" example:"2025-03-13T10:00:00Z"`
+	UpdatedAt   time.Time `json:"updated_at" example:"2025-03-13T11:30:00Z"`
+}
+
+func NewProjectResponse(p domain.Project) ProjectResponse {
+	return ProjectResponse{
+		ID:          p.ID,
+		Name:        p.Name,
+		Key:         p.Key,
+		Description: p.Description,
+		OwnerID:     p.OwnerID,
+		Archived:    p.Archived,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func NewProjectResponseList(projects []domain.Project) []ProjectResponse {
+	res := make([]ProjectResponse, 0, len(projects))
+	for _, p := range projects {
+		res = append(res, NewProjectResponse(p))
+	}
+	return res
+}
+
+// AddProjectMemberRequest adds a user to a project or changes their role.
+// @Description Project member request
+type AddProjectMemberRequest struct {
+	UserID uuid.UUID `json:"user_id" binding:"required" example:"f2bc33e0-103a-4d61-8a67-5ac5084e9fa1"`
+	// Role defaults to member.
+	Role string `json:"role,omitempty" binding:"omitempty,oneof=admin member viewer" example:"member"`
+}
+
+type ProjectMemberResponse struct {
+	ProjectID uuid.UUID `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	UserID    uuid.UUID `json:"user_id" example:"f2bc33e0-103a-4d61-8a67-5ac5084e9fa1"`
+	Role      string    `json:"role" example:"member"`
+	CreatedAt time.Time `json:"created_at" example:"2025-03-13T10:00:00Z"`
+}
+
+func NewProjectMemberResponse(m domain.ProjectMember) ProjectMemberResponse {
+	return ProjectMemberResponse{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Role:      string(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func NewProjectMemberResponseList(members []domain.ProjectMember) []ProjectMemberResponse {
+	res := make([]ProjectMemberResponse, 0, len(members))
+	for _, m := range members {
+		res = append(res, NewProjectMemberResponse(m))
+	}
+	return res
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// RecurrenceRequest is the payload for making a task recur.
+// @Description Recurrence rule request
+type RecurrenceRequest struct {
+	RRule    string `json:"rrule" binding:"required" example:"FREQ=WEEKLY;BYDAY=MO"`
+	Timezone string `json:"timezone,omitempty" example:"Asia/Ho_Chi_Minh"`
+}
+
+type SeriesResponse struct {
+	ID            uuid.UUID  `json:"id" example:"8e1f3b7a-2c4d-4f6e-9a0b-1c2d3e4f5a6b"`
+	ProjectID     uuid.UUID  `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	RRule         string     `json:"rrule" example:"FREQ=WEEKLY;BYDAY=MO"`
+	Timezone      string     `json:"timezone" example:"Asia/Ho_Chi_Minh"`
+	Start         time.Time  `json:"start" example:"2025-03-17T09:00:00+07:00"`
+	Title         string     `json:"title" example:"Rotate on-call"`
+	Description   string     `json:"description" example:"Hand over the pager"`
+	Priority      string     `json:"priority" example:"medium"`
+	AssignedTo    uuid.UUID  `json:"assigned_to" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	CurrentTaskID uuid.UUID  `json:"current_task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	LastAt        time.Time  `json:"last_at" example:"2025-03-17T09:00:00+07:00"`
+	NextAt        *time.Time `json:"next_at,omitempty" example:"2025-03-24T09:00:00+07:00"`
+	StoppedAt     *time.Time `json:"stopped_at,omitempty" example:"2025-04-01T08:00:00Z"`
+	Active        bool       `json:"active" example:"true"`
+	CreatedBy     uuid.UUID  `json:"created_by" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	CreatedAt     time.Time  `json:"created_at" example:"2025-03-13T10:00:00Z"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2025-03-13T10:00:00Z"`
+}
+
+func NewSeriesResponse(s domain.Series) SeriesResponse {
+	return SeriesResponse{
+		ID:            s.ID,
+		ProjectID:     s.ProjectID,
+		RRule:         s.RRule,
+		Timezone:      s.Timezone,
+		Start:         s.Start,
+		Title:         s.Title,
+		Description:   s.Description,
+		Priority:      string(s.Priority),
+		AssignedTo:    s.AssignedTo,
+		CurrentTaskID: s.CurrentTaskID,
+		LastAt:        s.LastAt,
+		NextAt:        s.NextAt,
+		StoppedAt:     s.StoppedAt,
+		Active:        s.Active(),
+		CreatedBy:     s.CreatedBy,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+}
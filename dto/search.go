@@ -0,0 +1,40 @@
+package dto
+
+import (
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type SearchHitResponse struct {
+	Kind      string     `json:"kind" example:"comment"`
+	TaskID    uuid.UUID  `json:"task_id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	CommentID *uuid.UUID `json:"comment_id,omitempty" example:"7c9e6679-7425-40de-944b-e07fc1f90ae7"`
+	ProjectID uuid.UUID  `json:"project_id" example:"f47ac10b-58cc-4372-a567-0e02b2c3d479"`
+	Title     string     `json:"title" example:"Fix login redirect"`
+	Snippet   string     `json:"snippet" example:"the <mark>redirect</mark> loops after the token expires"`
+	Rank      float32    `json:"rank" example:"0.2"`
+}
+
+// SearchPageResponse is one page of search hits, best match first.
+// @Description Paginated search results
+type SearchPageResponse struct {
+	Items      []SearchHitResponse `json:"items"`
+	NextCursor string              `json:"next_cursor,omitempty" example:"eyJzIjoicmFuayIsImsiOiIwLjEiLCJpZCI6IjdjOWU2Njc5In0"`
+}
+
+func NewSearchPageResponse(hits []domain.SearchHit, nextCursor string) SearchPageResponse {
+	items := make([]SearchHitResponse, 0, len(hits))
+	for _, h := range hits {
+		items = append(items, SearchHitResponse{
+			Kind:      string(h.Kind),
+			TaskID:    h.TaskID,
+			CommentID: h.CommentID,
+			ProjectID: h.ProjectID,
+			Title:     h.Title,
+			Snippet:   h.Snippet,
+			Rank:      h.Rank,
+		})
+	}
+	return SearchPageResponse{Items: items, NextCursor: nextCursor}
+}
@@ -11,18 +11,37 @@ import (
 // CreateTaskRequest is used to create a new task.
 // @Description Task creation payload
 type CreateTaskRequest struct {
-	Title       string    `json:"title" binding:"required" example:"Build demo"`
-	Description string    `json:"description" example:"Build a task manager demo with Go"`
-	Status      string    `json:"status" binding:"required" example:"open"`
-	ProjectID   uuid.UUID `json:"project_id" binding:"required" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	Title       string     `json:"title" binding:"required" example:"Build demo"`
+	Description string     `json:"description" example:"Build a task manager demo with Go"`
+	Status      string     `json:"status,omitempty" example:"open"`
+	Priority    string     `json:"priority,omitempty" binding:"omitempty,oneof=low medium high urgent" example:"high"`
+	ProjectID   uuid.UUID  `json:"project_id" binding:"required" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" example:"5d2a7e3b-8c14-4e0f-9b51-0c6d1f9a2e47"`
+	StartDate   *time.Time `json:"start_date,omitempty" example:"2025-03-14T09:00:00+07:00"`
+	DueDate     *time.Time `json:"due_date,omitempty" example:"2025-03-21T17:00:00+07:00"`
+}
+
+// CreateSubtaskRequest is used to create a subtask under an existing task.
+// @Description Subtask creation payload; the project is inherited from the parent
+type CreateSubtaskRequest struct {
+	Title       string     `json:"title" binding:"required" example:"Write migration"`
+	Description string     `json:"description" example:"Add the parent_id column"`
+	Status      string     `json:"status,omitempty" example:"open"`
+	Priority    string     `json:"priority,omitempty" binding:"omitempty,oneof=low medium high urgent" example:"medium"`
+	StartDate   *time.Time `json:"start_date,omitempty" example:"2025-03-14T09:00:00+07:00"`
+	DueDate     *time.Time `json:"due_date,omitempty" example:"2025-03-21T17:00:00+07:00"`
 }
 
 // UpdateTaskRequest is used to update an existing task.
 // @Description Task update payload
 type UpdateTaskRequest struct {
-	Title       *string `json:"title,omitempty" example:"Update title"`
-	Description *string `json:"description,omitempty" example:"New desc"`
-	Status      *string `json:"status,omitempty" example:"done"`
+	Title       *string    `json:"title,omitempty" example:"Update title"`
+	Description *string    `json:"description,omitempty" example:"New desc"`
+	Status      *string    `json:"status,omitempty" example:"done"`
+	Priority    *string    `json:"priority,omitempty" binding:"omitempty,oneof=low medium high urgent" example:"urgent"`
+	ParentID    *uuid.UUID `json:"parent_id,omitempty" example:"5d2a7e3b-8c14-4e0f-9b51-0c6d1f9a2e47"`
+	StartDate   *time.Time `json:"start_date,omitempty" example:"2025-03-14T09:00:00+07:00"`
+	DueDate     *time.Time `json:"due_date,omitempty" example:"2025-03-21T17:00:00+07:00"`
 }
 
 // AssignRequest represents the request body for assigning a task to a user.
@@ -31,15 +50,32 @@ type AssignRequest struct {
 	UserID uuid.UUID `json:"user_id" binding:"required" example:"f2bc33e0-103a-4d61-8a67-5ac5084e9fa1"`
 }
 
+// SubtaskRollup summarizes the progress of a task's direct subtasks.
+type SubtaskRollup struct {
+	Total int `json:"total" example:"4"`
+	Done  int `json:"done" example:"1"`
+}
+
 type TaskResponse struct {
-	ID          uuid.UUID `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
-	Title       string    `json:"title" example:"Fix payment bug"`
-	Description string    `json:"description" example:"Fix the bug on the payment screen that causes crashes"`
-	Status      string    `json:"status" example:"in_progress"`
-	AssignedTo  uuid.UUID `json:"assigned_to" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
-	ProjectID   uuid.UUID `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
-	CreatedAt   time.Time `json:"created_at" example:"2025-03-13T10:00:00Z"`
-	UpdatedAt   time.Time `json:"updated_at" example:"2025-03-13T11:30:00Z"`
+	ID          uuid.UUID       `json:"id" example:"3fa85f64-5717-4562-b3fc-2c963f66afa6"`
+	Title       string          `json:"title" example:"Fix payment bug"`
+	Description string          `json:"description" example:"Fix the bug on the payment screen that causes crashes"`
+	Status      string          `json:"status" example:"in_progress"`
+	Priority    string          `json:"priority" example:"high"`
+	AssignedTo  uuid.UUID       `json:"assigned_to" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
+	ProjectID   uuid.UUID       `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	ParentID    *uuid.UUID      `json:"parent_id,omitempty" example:"5d2a7e3b-8c14-4e0f-9b51-0c6d1f9a2e47"`
+	SeriesID    *uuid.UUID      `json:"series_id,omitempty" example:"8e1f3b7a-2c4d-4f6e-9a0b-1c2d3e4f5a6b"`
+	Subtasks    SubtaskRollup   `json:"subtasks"`
+	Labels      []LabelResponse `json:"labels"`
+	StartDate   *time.Time      `json:"start_date,omitempty" example:"2025-03-14T09:00:00+07:00"`
+	DueDate     *time.Time      `json:"due_date,omitempty" example:"2025-03-21T17:00:00+07:00"`
+	CompletedAt *time.Time      `json:"completed_at,omitempty" example:"2025-03-20T15:45:00Z"`
+	CreatedAt   time.Time       `json:"created_at" example:"2025-03-13T10:00:00Z"`
+	UpdatedAt   time.Time       `json:"updated_at" example:"2025-03-13T11:30:00Z"`
+	Version     int64           `json:"version" example:"3"`
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty" example:"2025-03-22T08:00:00Z"`
+	DeletedBy   *uuid.UUID      `json:"deleted_by,omitempty" example:"c55c8ee2-5552-4b6c-9f49-bb2e3f0d9d22"`
 }
 
 func NewTaskResponse(t domain.Task) TaskResponse {
@@ -48,10 +84,21 @@ func NewTaskResponse(t domain.Task) TaskResponse {
 		Title:       t.Title,
 		Description: t.Description,
 		Status:      t.Status,
+		Priority:    string(t.Priority),
 		AssignedTo:  t.AssignedTo,
 		ProjectID:   t.ProjectID,
+		ParentID:    t.ParentID,
+		SeriesID:    t.SeriesID,
+		Subtasks:    SubtaskRollup{Total: t.Subtasks.Total, Done: t.Subtasks.Done},
+		Labels:      NewLabelResponseList(t.Labels),
+		StartDate:   t.StartDate,
+		DueDate:     t.DueDate,
+		CompletedAt: t.CompletedAt,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
+		Version:     t.Version,
+		DeletedAt:   t.DeletedAt,
+		DeletedBy:   t.DeletedBy,
 	}
 }
 
@@ -62,3 +109,14 @@ func NewTaskResponseList(tasks []domain.Task) []TaskResponse {
 	}
 	return res
 }
+
+// TaskPageResponse is one page of a task list.
+// @Description Paginated task list
+type TaskPageResponse struct {
+	Items      []TaskResponse `json:"items"`
+	NextCursor string         `json:"next_cursor,omitempty" example:"eyJzIjoiY3JlYXRlZF9hdCBkZXNjIiwiayI6IjIwMjUtMDMtMTNUMTA6MDA6MDBaIn0"`
+}
+
+func NewTaskPageResponse(tasks []domain.Task, nextCursor string) TaskPageResponse {
+	return TaskPageResponse{Items: NewTaskResponseList(tasks), NextCursor: nextCursor}
+}
@@ -0,0 +1,169 @@
+package dto
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// CreateWebhookRequest subscribes a URL to task events of a project.
+// @Description Webhook creation request
+type CreateWebhookRequest struct {
+	URL string `json:"url" binding:"required" example:"https://hooks.example.com/tasks"`
+	// Secret signs deliveries; one is generated when left empty.
+	Secret string   `json:"secret,omitempty" example:"4f9a0c2e7b1d4e6a8c3f5b7d9e1a2c4e"`
+	Events []string `json:"events" binding:"required" example:"task.created,task.commented"`
+}
+
+func (r CreateWebhookRequest) EventTypes() []domain.TaskEventType {
+	return eventTypes(r.Events)
+}
+
+// UpdateWebhookRequest changes a webhook. Omitted fields are left unchanged.
+// @Description Webhook update request
+type UpdateWebhookRequest struct {
+	URL    *string  `json:"url,omitempty" example:"https://hooks.example.com/tasks"`
+	Secret *string  `json:"secret,omitempty" example:"4f9a0c2e7b1d4e6a8c3f5b7d9e1a2c4e"`
+	Events []string `json:"events,omitempty" example:"task.created,task.updated"`
+	Active *bool    `json:"active,omitempty" example:"false"`
+}
+
+func (r UpdateWebhookRequest) EventTypes() []domain.TaskEventType {
+	if r.Events == nil {
+		return nil
+	}
+	return eventTypes(r.Events)
+}
+
+func eventTypes(names []string) []domain.TaskEventType {
+	events := make([]domain.TaskEventType, 0, len(names))
+	for _, name := range names {
+		events = append(events, domain.TaskEventType(name))
+	}
+	return events
+}
+
+// WebhookResponse describes a webhook. The secret is only returned on creation.
+type WebhookResponse struct {
+	ID        uuid.UUID `json:"id" example:"5d1c7a2b-8e4f-4b6a-9c3d-2e1f0a9b8c7d"`
+	ProjectID uuid.UUID `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	URL       string    `json:"url" example:"https://hooks.example.com/tasks"`
+	Events    []string  `json:"events" example:"task.created,task.commented"`
+	Active    bool      `json:"active" example:"true"`
+	Secret    string    `json:"secret,omitempty" example:"4f9a0c2e7b1d4e6a8c3f5b7d9e1a2c4e"`
+	CreatedAt time.Time `json:"created_at" example:"2025-03-13T10:00:00Z"`
+	UpdatedAt time.Time `json:"updated_at" example:"2025-03-13T10:00:00Z"`
+}
+
+func NewWebhookResponse(w domain.Webhook) WebhookResponse {
+	events := make([]string, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, string(e))
+	}
+	return WebhookResponse{
+		ID:        w.ID,
+		ProjectID: w.ProjectID,
+		URL:       w.URL,
+		Events:    events,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+}
+
+func NewWebhookResponseList(hooks []domain.Webhook) []WebhookResponse {
+	res := make([]WebhookResponse, 0, len(hooks))
+	for _, w := range hooks {
+		res = append(res, NewWebhookResponse(w))
+	}
+	return res
+}
+
+type WebhookDeliveryResponse struct {
+	ID            uuid.UUID  `json:"id" example:"8a6b4c2d-0e1f-4a3b-9c5d-7e8f9a0b1c2d"`
+	WebhookID     uuid.UUID  `json:"webhook_id" example:"5d1c7a2b-8e4f-4b6a-9c3d-2e1f0a9b8c7d"`
+	EventID       uuid.UUID  `json:"event_id" example:"2c4e6a8c-0e2f-4a6b-8d0f-1a3c5e7a9c1e"`
+	EventType     string     `json:"event_type" example:"task.created"`
+	Status        string     `json:"status" example:"sent" enums:"pending,sent,failed"`
+	Attempts      int        `json:"attempts" example:"1"`
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty" example:"2025-03-13T10:01:00Z"`
+	LastError     string     `json:"last_error,omitempty" example:"subscriber responded 503 Service Unavailable"`
+	ReplayOf      *uuid.UUID `json:"replay_of,omitempty" example:"1b3d5f7a-9c1e-4a3c-8e5a-7c9e1a3c5e7a"`
+	CreatedAt     time.Time  `json:"created_at" example:"2025-03-13T10:00:00Z"`
+	UpdatedAt     time.Time  `json:"updated_at" example:"2025-03-13T10:00:01Z"`
+}
+
+func NewWebhookDeliveryResponse(d domain.WebhookDelivery) WebhookDeliveryResponse {
+	res := WebhookDeliveryResponse{
+		ID:        d.ID,
+		WebhookID: d.WebhookID,
+		EventID:   d.EventID,
+		EventType: string(d.EventType),
+		Status:    string(d.Status),
+		Attempts:  d.Attempts,
+		LastError: d.LastError,
+		ReplayOf:  d.ReplayOf,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+	}
+	if d.Status == domain.DeliveryPending {
+		next := d.NextAttemptAt
+		res.NextAttemptAt = &next
+	}
+	return res
+}
+
+// WebhookDeliveryPageResponse is one page of a webhook's delivery log, newest first.
+// @Description Paginated webhook deliveries
+type WebhookDeliveryPageResponse struct {
+	Items      []WebhookDeliveryResponse `json:"items"`
+	NextCursor string                    `json:"next_cursor,omitempty" example:"eyJrIjoiMjAyNS0wMy0xM1QxMDowMDowMFoiLCJpZCI6IjhhNmI0YzJkIn0"`
+}
+
+func NewWebhookDeliveryPageResponse(deliveries []domain.WebhookDelivery, nextCursor string) WebhookDeliveryPageResponse {
+	items := make([]WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, NewWebhookDeliveryResponse(d))
+	}
+	return WebhookDeliveryPageResponse{Items: items, NextCursor: nextCursor}
+}
+
+type WebhookAttemptResponse struct {
+	Attempt     int       `json:"attempt" example:"1"`
+	StatusCode  int       `json:"status_code,omitempty" example:"503"`
+	Error       string    `json:"error,omitempty" example:"subscriber responded 503 Service Unavailable"`
+	DurationMs  int64     `json:"duration_ms" example:"142"`
+	AttemptedAt time.Time `json:"attempted_at" example:"2025-03-13T10:00:01Z"`
+}
+
+// WebhookDeliveryDetailResponse is a delivery with the payload it sends and
+// the log of its attempts.
+type WebhookDeliveryDetailResponse struct {
+	WebhookDeliveryResponse
+	// Payload is the JSON body exactly as signed and sent.
+	Payload    string                   `json:"payload" example:"{\"version\":1,\"type\":\"task.created\"}"`
+	AttemptLog []WebhookAttemptResponse `json:"attempt_log"`
+}
+
+func NewWebhookDeliveryDetailResponse(
+	d domain.WebhookDelivery,
+	attempts []domain.WebhookAttempt,
+) WebhookDeliveryDetailResponse {
+	log := make([]WebhookAttemptResponse, 0, len(attempts))
+	for _, a := range attempts {
+		log = append(log, WebhookAttemptResponse{
+			Attempt:     a.Attempt,
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+			AttemptedAt: a.AttemptedAt,
+		})
+	}
+	return WebhookDeliveryDetailResponse{
+		WebhookDeliveryResponse: NewWebhookDeliveryResponse(d),
+		Payload:                 d.Payload,
+		AttemptLog:              log,
+	}
+}
@@ -0,0 +1,73 @@
+package dto
+
+import (
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// WorkflowStatus describes one status of a project workflow.
+// @Description Workflow status
+type WorkflowStatus struct {
+	Name     string `json:"name" binding:"required" example:"in_progress"`
+	Category string `json:"category" binding:"required,oneof=todo in_progress done" example:"in_progress"`
+}
+
+// WorkflowTransition describes an allowed move between two statuses.
+// @Description Workflow transition
+type WorkflowTransition struct {
+	From string `json:"from" binding:"required" example:"open"`
+	To   string `json:"to" binding:"required" example:"in_progress"`
+}
+
+// WorkflowRequest is used to define the workflow of a project.
+// @Description Project workflow definition
+type WorkflowRequest struct {
+	InitialStatus string               `json:"initial_status" binding:"required" example:"open"`
+	Statuses      []WorkflowStatus     `json:"statuses" binding:"required,min=1,dive"`
+	Transitions   []WorkflowTransition `json:"transitions" binding:"dive"`
+}
+
+func (r WorkflowRequest) ToDomain(projectID uuid.UUID) *domain.Workflow {
+	wf := &domain.Workflow{
+		ProjectID:     projectID,
+		InitialStatus: r.InitialStatus,
+		Statuses:      make([]domain.WorkflowStatus, 0, len(r.Statuses)),
+		Transitions:   make([]domain.WorkflowTransition, 0, len(r.Transitions)),
+	}
+	for _, st := range r.Statuses {
+		wf.Statuses = append(wf.Statuses, domain.WorkflowStatus{
+			Name:     st.Name,
+			Category: domain.StatusCategory(st.Category),
+		})
+	}
+	for _, tr := range r.Transitions {
+		wf.Transitions = append(wf.Transitions, domain.WorkflowTransition{From: tr.From, To: tr.To})
+	}
+	return wf
+}
+
+// WorkflowResponse is the workflow that applies to a project.
+// @Description Project workflow
+type WorkflowResponse struct {
+	ProjectID     uuid.UUID            `json:"project_id" example:"a3d8d6f3-11de-43a0-8e62-330ac6118c15"`
+	InitialStatus string               `json:"initial_status" example:"open"`
+	Statuses      []WorkflowStatus     `json:"statuses"`
+	Transitions   []WorkflowTransition `json:"transitions"`
+}
+
+func NewWorkflowResponse(wf domain.Workflow) WorkflowResponse {
+	res := WorkflowResponse{
+		ProjectID:     wf.ProjectID,
+		InitialStatus: wf.InitialStatus,
+		Statuses:      make([]WorkflowStatus, 0, len(wf.Statuses)),
+		Transitions:   make([]WorkflowTransition, 0, len(wf.Transitions)),
+	}
+	for _, st := range wf.Statuses {
+		res.Statuses = append(res.Statuses, WorkflowStatus{Name: st.Name, Category: string(st.Category)})
+	}
+	for _, tr := range wf.Transitions {
+		res.Transitions = append(res.Transitions, WorkflowTransition{From: tr.From, To: tr.To})
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package blobstore_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"testing"
+
+	"task-manager/attachment"
+	"task-manager/domain"
+	"task-manager/internal/blobstore"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFSStore(t *testing.T) {
+	store, err := blobstore.NewFSStore(t.TempDir())
+	require.NoError(t, err)
+
+	testStore(t, store)
+}
+
+// TestS3Store runs against a local stand-in such as the MinIO service in
+// compose.yaml, e.g. S3_TEST_ENDPOINT=localhost:9000.
+func TestS3Store(t *testing.T) {
+	endpoint := os.Getenv("S3_TEST_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("S3_TEST_ENDPOINT not set")
+	}
+
+	store, err := blobstore.NewS3Store(context.Background(), blobstore.S3Config{
+		Endpoint:  endpoint,
+		Region:    "us-east-1",
+		Bucket:    "attachments-test",
+		AccessKey: envOr("S3_TEST_ACCESS_KEY", "minio"),
+		SecretKey: envOr("S3_TEST_SECRET_KEY", "minio123"),
+	})
+	require.NoError(t, err)
+
+	testStore(t, store)
+}
+
+func testStore(t *testing.T, store attachment.BlobStore) {
+	ctx := context.Background()
+	key := "tasks/test/blob"
+	content := []byte("hello attachments")
+
+	// Unknown size, as with multipart and streamed uploads
+	require.NoError(t, store.Put(ctx, key, bytes.NewReader(content), -1, "text/plain"))
+
+	r, err := store.Get(ctx, key)
+	require.NoError(t, err)
+	got, err := io.ReadAll(r)
+	require.NoError(t, r.Close())
+	require.NoError(t, err)
+	assert.Equal(t, content, got)
+
+	require.NoError(t, store.Delete(ctx, key))
+	_, err = store.Get(ctx, key)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func envOr(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"task-manager/domain"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type AttachmentService interface {
+	Upload(ctx context.Context, taskID uuid.UUID, commentID *uuid.UUID, uploaderID uuid.UUID, fileName string, size int64, r io.Reader) (*domain.Attachment, error)
+	List(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error)
+	Delete(ctx context.Context, taskID, id, userID uuid.UUID) error
+}
+
+type AttachmentServer struct {
+	taskmanagerpb.UnimplementedAttachmentServiceServer
+	service AttachmentService
+}
+
+func NewAttachmentServer(service AttachmentService) *AttachmentServer {
+	return &AttachmentServer{service: service}
+}
+
+// UploadAttachment reads the upload info from the first message and streams
+// the chunks that follow into the service, like the multipart REST endpoint.
+func (s *AttachmentServer) UploadAttachment(
+	stream taskmanagerpb.AttachmentService_UploadAttachmentServer,
+) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "missing upload info: %v", err)
+	}
+	info := first.GetInfo()
+	if info == nil {
+		return status.Error(codes.InvalidArgument, "the first message must carry the upload info")
+	}
+
+	taskID, err := uuid.Parse(info.GetTaskId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+	var commentID *uuid.UUID
+	if raw := info.GetCommentId(); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid comment_id: %v", err)
+		}
+		commentID = &id
+	}
+	size := info.GetSize()
+	if size <= 0 {
+		size = -1
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+
+	content := &uploadChunkReader{stream: stream}
+	attachment, err := s.service.Upload(ctx, taskID, commentID, userID, info.GetFileName(), size, content)
+	if content.err != nil {
+		return content.err
+	}
+	if err != nil {
+		return status.Errorf(codeForError(err), "upload failed: %v", err)
+	}
+
+	return stream.SendAndClose(&taskmanagerpb.AttachmentReply{Attachment: mapAttachmentToProto(attachment)})
+}
+
+func (s *AttachmentServer) ListAttachments(
+	ctx context.Context,
+	req *taskmanagerpb.ListAttachmentsRequest,
+) (*taskmanagerpb.ListAttachmentsReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	attachments, err := s.service.List(ctx, taskID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list attachments: %v", err)
+	}
+
+	res := make([]*taskmanagerpb.Attachment, 0, len(attachments))
+	for i := range attachments {
+		res = append(res, mapAttachmentToProto(&attachments[i]))
+	}
+	return &taskmanagerpb.ListAttachmentsReply{Attachments: res}, nil
+}
+
+func (s *AttachmentServer) DeleteAttachment(
+	ctx context.Context,
+	req *taskmanagerpb.DeleteAttachmentRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+	attachmentID, err := uuid.Parse(req.GetAttachmentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid attachment_id: %v", err)
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.Delete(ctx, taskID, attachmentID, userID); err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to delete attachment: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Attachment deleted successfully"}, nil
+}
+
+// uploadChunkReader reads the content chunks of an upload stream. A stream
+// failure is kept in err so that it is reported as is, rather than as an
+// upload error.
+type uploadChunkReader struct {
+	stream taskmanagerpb.AttachmentService_UploadAttachmentServer
+	buf    []byte
+	err    error
+}
+
+func (r *uploadChunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return 0, io.EOF
+		}
+		if err != nil {
+			r.err = err
+			return 0, err
+		}
+		if msg.GetInfo() != nil {
+			r.err = status.Error(codes.InvalidArgument, "upload info must only be sent once")
+			return 0, r.err
+		}
+		r.buf = msg.GetChunk()
+	}
+
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
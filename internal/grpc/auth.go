@@ -12,7 +12,9 @@ import (
 
 type AuthService interface {
 	Register(ctx context.Context, req dto.RegisterRequest) error
-	Login(ctx context.Context, req dto.LoginRequest) (string, error)
+	Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error)
+	Refresh(ctx context.Context, req dto.RefreshTokenRequest) (*dto.LoginResponse, error)
+	Logout(ctx context.Context, req dto.LogoutRequest) error
 }
 
 type AuthServer struct {
@@ -53,12 +55,47 @@ func (s *AuthServer) Login(ctx context.Context, req *taskmanagerpb.LoginRequest)
 	}
 
 	// Call service
-	token, err := s.authService.Login(ctx, loginReq)
+	resp, err := s.authService.Login(ctx, loginReq)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, status.Error(codeForError(err), err.Error())
 	}
 
-	return &taskmanagerpb.LoginReply{
-		AccessToken: token,
+	return toLoginReply(resp), nil
+}
+
+func (s *AuthServer) RefreshToken(ctx context.Context, req *taskmanagerpb.RefreshTokenRequest) (*taskmanagerpb.LoginReply, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	resp, err := s.authService.Refresh(ctx, dto.RefreshTokenRequest{RefreshToken: req.GetRefreshToken()})
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "refresh token failed: %v", err)
+	}
+
+	return toLoginReply(resp), nil
+}
+
+func (s *AuthServer) Logout(ctx context.Context, req *taskmanagerpb.LogoutRequest) (*taskmanagerpb.SuccessResponse, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+
+	if err := s.authService.Logout(ctx, dto.LogoutRequest{RefreshToken: req.GetRefreshToken()}); err != nil {
+		return nil, status.Errorf(codeForError(err), "logout failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{
+		Message: "Logged out successfully",
 	}, nil
 }
+
+func toLoginReply(resp *dto.LoginResponse) *taskmanagerpb.LoginReply {
+	return &taskmanagerpb.LoginReply{
+		AccessToken:      resp.AccessToken,
+		ExpiresIn:        resp.ExpiresIn,
+		RefreshToken:     resp.RefreshToken,
+		RefreshExpiresIn: resp.RefreshExpiresIn,
+		TokenType:        resp.TokenType,
+	}
+}
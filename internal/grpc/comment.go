@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+
+	"task-manager/internal/grpc/middleware"
+	"task-manager/pkg/pagination"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *TaskServer) CommentOnTask(
+	ctx context.Context,
+	req *taskmanagerpb.CommentTaskRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	if _, err := s.AddComment(ctx, req); err != nil {
+		return nil, err
+	}
+	return &taskmanagerpb.SuccessResponse{Message: "Comment added successfully"}, nil
+}
+
+func (s *TaskServer) AddComment(
+	ctx context.Context,
+	req *taskmanagerpb.CommentTaskRequest,
+) (*taskmanagerpb.CommentReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	var parentID *uuid.UUID
+	if raw := req.GetParentId(); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid parent_id: %v", err)
+		}
+		parentID = &id
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.service.Comment(ctx, taskID, userID, req.GetContent(), parentID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "comment failed: %v", err)
+	}
+
+	return &taskmanagerpb.CommentReply{Comment: mapCommentToProto(comment)}, nil
+}
+
+func (s *TaskServer) ListComments(
+	ctx context.Context,
+	req *taskmanagerpb.ListCommentsRequest,
+) (*taskmanagerpb.ListCommentsReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	page := pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+	comments, next, err := s.service.ListComments(ctx, taskID, page)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list comments failed: %v", err)
+	}
+
+	protoComments := make([]*taskmanagerpb.Comment, 0, len(comments))
+	for i := range comments {
+		protoComments = append(protoComments, mapCommentToProto(&comments[i]))
+	}
+
+	return &taskmanagerpb.ListCommentsReply{
+		Comments:      protoComments,
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *TaskServer) EditComment(
+	ctx context.Context,
+	req *taskmanagerpb.EditCommentRequest,
+) (*taskmanagerpb.CommentReply, error) {
+	taskID, commentID, err := parseCommentRef(req.GetTaskId(), req.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	comment, err := s.service.EditComment(ctx, taskID, commentID, userID, req.GetContent())
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "edit comment failed: %v", err)
+	}
+
+	return &taskmanagerpb.CommentReply{Comment: mapCommentToProto(comment)}, nil
+}
+
+func (s *TaskServer) DeleteComment(
+	ctx context.Context,
+	req *taskmanagerpb.DeleteCommentRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	taskID, commentID, err := parseCommentRef(req.GetTaskId(), req.GetCommentId())
+	if err != nil {
+		return nil, err
+	}
+
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.DeleteComment(ctx, taskID, commentID, userID); err != nil {
+		return nil, status.Errorf(codeForError(err), "delete comment failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Comment deleted successfully"}, nil
+}
+
+func parseCommentRef(rawTaskID, rawCommentID string) (uuid.UUID, uuid.UUID, error) {
+	taskID, err := uuid.Parse(rawTaskID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+	commentID, err := uuid.Parse(rawCommentID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid comment_id: %v", err)
+	}
+	return taskID, commentID, nil
+}
+
+// callerID returns the authenticated user set by the JWT interceptor.
+func callerID(ctx context.Context) (uuid.UUID, error) {
+	userIDStr, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return uuid.Nil, status.Error(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+	return userID, nil
+}
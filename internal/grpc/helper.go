@@ -1,21 +1,392 @@
 package grpc
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"task-manager/domain"
+	"task-manager/pkg/keycloak"
+	"task-manager/pkg/pagination"
 	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
 )
 
+// codeForError maps domain errors to gRPC status codes, falling back to Internal.
+func codeForError(err error) codes.Code {
+	var transitionErr *domain.TransitionError
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, domain.ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, keycloak.ErrInvalidGrant):
+		return codes.Unauthenticated
+	case errors.Is(err, domain.ErrConflict):
+		return codes.AlreadyExists
+	case errors.Is(err, domain.ErrVersionConflict):
+		return codes.Aborted
+	case errors.Is(err, domain.ErrHasSubtasks), errors.Is(err, domain.ErrDependencyCycle),
+		errors.As(err, &transitionErr):
+		return codes.FailedPrecondition
+	case errors.Is(err, domain.ErrInvalidWorkflow), errors.Is(err, domain.ErrInvalidTask),
+		errors.Is(err, domain.ErrInvalidProject), errors.Is(err, domain.ErrInvalidLabel),
+		errors.Is(err, domain.ErrInvalidComment), errors.Is(err, domain.ErrInvalidAttachment),
+		errors.Is(err, domain.ErrAttachmentTooLarge), errors.Is(err, domain.ErrUnsupportedMediaType),
+		errors.Is(err, domain.ErrInvalidRecurrence), errors.Is(err, domain.ErrInvalidPreferences),
+		errors.Is(err, domain.ErrInvalidWebhook), errors.Is(err, domain.ErrInvalidQuery),
+		errors.Is(err, pagination.ErrInvalidCursor):
+		return codes.InvalidArgument
+	default:
+		return codes.Internal
+	}
+}
+
 func mapTaskToProto(t *domain.Task) *taskmanagerpb.Task {
+	var parentID, seriesID, deletedBy string
+	if t.ParentID != nil {
+		parentID = t.ParentID.String()
+	}
+	if t.SeriesID != nil {
+		seriesID = t.SeriesID.String()
+	}
+	if t.DeletedBy != nil {
+		deletedBy = t.DeletedBy.String()
+	}
+
 	return &taskmanagerpb.Task{
 		Id:          t.ID.String(),
 		Title:       t.Title,
 		Description: t.Description,
 		Status:      t.Status,
+		Priority:    mapPriorityToProto(t.Priority),
 		ProjectId:   t.ProjectID.String(),
 		AssignedTo:  t.AssignedTo.String(),
+		ParentId:    parentID,
+		SeriesId:    seriesID,
+		Subtasks: &taskmanagerpb.SubtaskRollup{
+			Total: int32(t.Subtasks.Total),
+			Done:  int32(t.Subtasks.Done),
+		},
+		Labels:      mapLabelsToProto(t.Labels),
+		StartDate:   formatOptionalTime(t.StartDate),
+		DueDate:     formatOptionalTime(t.DueDate),
+		CompletedAt: formatOptionalTime(t.CompletedAt),
 		CreatedAt:   t.CreatedAt.Format(time.RFC3339),
 		UpdatedAt:   t.UpdatedAt.Format(time.RFC3339),
+		DeletedAt:   formatOptionalTime(t.DeletedAt),
+		DeletedBy:   deletedBy,
+		Version:     t.Version,
+	}
+}
+
+func mapLabelToProto(l *domain.Label) *taskmanagerpb.Label {
+	return &taskmanagerpb.Label{
+		Id:        l.ID.String(),
+		ProjectId: l.ProjectID.String(),
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: l.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func mapLabelsToProto(labels []domain.Label) []*taskmanagerpb.Label {
+	var protoLabels []*taskmanagerpb.Label
+	for _, label := range labels {
+		protoLabels = append(protoLabels, mapLabelToProto(&label))
+	}
+	return protoLabels
+}
+
+func mapActivitiesToProto(activities []domain.Activity) []*taskmanagerpb.TaskActivity {
+	var protoActivities []*taskmanagerpb.TaskActivity
+	for _, a := range activities {
+		var changes []*taskmanagerpb.FieldChange
+		for _, c := range a.Changes {
+			changes = append(changes, &taskmanagerpb.FieldChange{Field: c.Field, From: c.From, To: c.To})
+		}
+		protoActivities = append(protoActivities, &taskmanagerpb.TaskActivity{
+			Id:        a.ID.String(),
+			TaskId:    a.TaskID.String(),
+			ActorId:   a.ActorID.String(),
+			Action:    string(a.Action),
+			Changes:   changes,
+			CreatedAt: a.CreatedAt.Format(time.RFC3339),
+		})
+	}
+	return protoActivities
+}
+
+func mapCommentToProto(c *domain.Comment) *taskmanagerpb.Comment {
+	res := &taskmanagerpb.Comment{
+		Id:        c.ID.String(),
+		TaskId:    c.TaskID.String(),
+		UserId:    c.UserID.String(),
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt.Format(time.RFC3339),
+	}
+	if c.ParentID != nil {
+		res.ParentId = c.ParentID.String()
+	}
+	if c.EditedAt != nil {
+		res.EditedAt = c.EditedAt.Format(time.RFC3339)
+	}
+	return res
+}
+
+func mapSeriesToProto(s *domain.Series) *taskmanagerpb.Series {
+	return &taskmanagerpb.Series{
+		Id:            s.ID.String(),
+		ProjectId:     s.ProjectID.String(),
+		Rrule:         s.RRule,
+		Timezone:      s.Timezone,
+		Start:         s.Start.Format(time.RFC3339),
+		Title:         s.Title,
+		Description:   s.Description,
+		Priority:      mapPriorityToProto(s.Priority),
+		AssignedTo:    s.AssignedTo.String(),
+		CurrentTaskId: s.CurrentTaskID.String(),
+		LastAt:        s.LastAt.Format(time.RFC3339),
+		NextAt:        formatOptionalTime(s.NextAt),
+		StoppedAt:     formatOptionalTime(s.StoppedAt),
+		Active:        s.Active(),
+		CreatedBy:     s.CreatedBy.String(),
+		CreatedAt:     s.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     s.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func mapAttachmentToProto(a *domain.Attachment) *taskmanagerpb.Attachment {
+	res := &taskmanagerpb.Attachment{
+		Id:          a.ID.String(),
+		TaskId:      a.TaskID.String(),
+		UploaderId:  a.UploaderID.String(),
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Sha256:      a.SHA256,
+		CreatedAt:   a.CreatedAt.Format(time.RFC3339),
+	}
+	if a.CommentID != nil {
+		res.CommentId = a.CommentID.String()
+	}
+	return res
+}
+
+var searchKindToProto = map[domain.SearchKind]taskmanagerpb.SearchHitKind{
+	domain.SearchKindTask:    taskmanagerpb.SearchHitKind_SEARCH_HIT_KIND_TASK,
+	domain.SearchKindComment: taskmanagerpb.SearchHitKind_SEARCH_HIT_KIND_COMMENT,
+}
+
+func mapSearchHitsToProto(hits []domain.SearchHit) []*taskmanagerpb.SearchHit {
+	var protoHits []*taskmanagerpb.SearchHit
+	for _, h := range hits {
+		hit := &taskmanagerpb.SearchHit{
+			Kind:      searchKindToProto[h.Kind],
+			TaskId:    h.TaskID.String(),
+			ProjectId: h.ProjectID.String(),
+			Title:     h.Title,
+			Snippet:   h.Snippet,
+			Rank:      h.Rank,
+		}
+		if h.CommentID != nil {
+			hit.CommentId = h.CommentID.String()
+		}
+		protoHits = append(protoHits, hit)
+	}
+	return protoHits
+}
+
+// taskListRequest is the filtering, sorting and paging surface shared by
+// GetUserTasksRequest and GetProjectTasksRequest.
+type taskListRequest interface {
+	GetLabelIds() []string
+	GetMatchAllLabels() bool
+	GetStatuses() []string
+	GetQuery() string
+	GetCreatedFrom() string
+	GetCreatedTo() string
+	GetSortBy() taskmanagerpb.TaskSortField
+	GetSortOrder() taskmanagerpb.SortOrder
+	GetPageSize() int32
+	GetPageToken() string
+}
+
+// parseTaskFilter builds a task filter from the filter fields of a list request.
+func parseTaskFilter(req taskListRequest) (domain.TaskFilter, error) {
+	filter := domain.TaskFilter{
+		MatchAllLabels: req.GetMatchAllLabels(),
+		Statuses:       req.GetStatuses(),
+		Text:           strings.TrimSpace(req.GetQuery()),
+	}
+	for _, raw := range req.GetLabelIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return domain.TaskFilter{}, fmt.Errorf("invalid label_ids: %w", err)
+		}
+		filter.LabelIDs = append(filter.LabelIDs, id)
+	}
+	if raw := req.GetCreatedFrom(); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return domain.TaskFilter{}, fmt.Errorf("invalid created_from: %w", err)
+		}
+		filter.CreatedFrom = &t
+	}
+	if raw := req.GetCreatedTo(); raw != "" {
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return domain.TaskFilter{}, fmt.Errorf("invalid created_to: %w", err)
+		}
+		filter.CreatedTo = &t
+	}
+	return filter, nil
+}
+
+var sortFieldFromProto = map[taskmanagerpb.TaskSortField]domain.TaskSortField{
+	taskmanagerpb.TaskSortField_TASK_SORT_FIELD_UNSPECIFIED: domain.TaskSortCreatedAt,
+	taskmanagerpb.TaskSortField_TASK_SORT_FIELD_CREATED_AT:  domain.TaskSortCreatedAt,
+	taskmanagerpb.TaskSortField_TASK_SORT_FIELD_UPDATED_AT:  domain.TaskSortUpdatedAt,
+	taskmanagerpb.TaskSortField_TASK_SORT_FIELD_DUE_DATE:    domain.TaskSortDueDate,
+	taskmanagerpb.TaskSortField_TASK_SORT_FIELD_PRIORITY:    domain.TaskSortPriority,
+}
+
+func parseTaskSort(req taskListRequest) (domain.TaskSort, error) {
+	field, ok := sortFieldFromProto[req.GetSortBy()]
+	if !ok {
+		return domain.TaskSort{}, fmt.Errorf("unknown sort_by %v", req.GetSortBy())
+	}
+	switch req.GetSortOrder() {
+	case taskmanagerpb.SortOrder_SORT_ORDER_UNSPECIFIED:
+		return domain.TaskSort{Field: field, Desc: field != domain.TaskSortDueDate}, nil
+	case taskmanagerpb.SortOrder_SORT_ORDER_ASC:
+		return domain.TaskSort{Field: field}, nil
+	case taskmanagerpb.SortOrder_SORT_ORDER_DESC:
+		return domain.TaskSort{Field: field, Desc: true}, nil
+	default:
+		return domain.TaskSort{}, fmt.Errorf("unknown sort_order %v", req.GetSortOrder())
+	}
+}
+
+func parseTaskPage(req taskListRequest) pagination.Request {
+	return pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+}
+
+func mapTasksToProto(tasks []domain.Task) []*taskmanagerpb.Task {
+	var protoTasks []*taskmanagerpb.Task
+	for _, task := range tasks {
+		protoTasks = append(protoTasks, mapTaskToProto(&task))
+	}
+	return protoTasks
+}
+
+var priorityToProto = map[domain.Priority]taskmanagerpb.TaskPriority{
+	domain.PriorityLow:    taskmanagerpb.TaskPriority_TASK_PRIORITY_LOW,
+	domain.PriorityMedium: taskmanagerpb.TaskPriority_TASK_PRIORITY_MEDIUM,
+	domain.PriorityHigh:   taskmanagerpb.TaskPriority_TASK_PRIORITY_HIGH,
+	domain.PriorityUrgent: taskmanagerpb.TaskPriority_TASK_PRIORITY_URGENT,
+}
+
+func mapPriorityToProto(p domain.Priority) taskmanagerpb.TaskPriority {
+	return priorityToProto[p]
+}
+
+// mapPriorityFromProto returns an empty priority for TASK_PRIORITY_UNSPECIFIED.
+func mapPriorityFromProto(p taskmanagerpb.TaskPriority) domain.Priority {
+	for d, pb := range priorityToProto {
+		if pb == p {
+			return d
+		}
+	}
+	return ""
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// parseOptionalUUID parses a UUID, returning nil for an empty string.
+func parseOptionalUUID(s string) (*uuid.UUID, error) {
+	if s == "" {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// parseOptionalTime parses an RFC 3339 timestamp, returning nil for an empty string.
+func parseOptionalTime(s string) (*time.Time, error) {
+	if s == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func parseTimeRange(from, to string) (time.Time, time.Time, error) {
+	start, err := time.Parse(time.RFC3339, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid from: %w", err)
+	}
+	end, err := time.Parse(time.RFC3339, to)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid to: %w", err)
+	}
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, errors.New("to must be after from")
+	}
+	return start, end, nil
+}
+
+func parseLocation(tz string) (*time.Location, error) {
+	if tz == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(tz)
+}
+
+func mapWorkflowToProto(wf *domain.Workflow) *taskmanagerpb.Workflow {
+	res := &taskmanagerpb.Workflow{
+		ProjectId:     wf.ProjectID.String(),
+		InitialStatus: wf.InitialStatus,
+	}
+	for _, st := range wf.Statuses {
+		res.Statuses = append(res.Statuses, &taskmanagerpb.WorkflowStatus{
+			Name:     st.Name,
+			Category: string(st.Category),
+		})
+	}
+	for _, tr := range wf.Transitions {
+		res.Transitions = append(res.Transitions, &taskmanagerpb.WorkflowTransition{From: tr.From, To: tr.To})
+	}
+	return res
+}
+
+func mapWorkflowFromProto(projectID uuid.UUID, wf *taskmanagerpb.Workflow) *domain.Workflow {
+	res := &domain.Workflow{
+		ProjectID:     projectID,
+		InitialStatus: wf.GetInitialStatus(),
+	}
+	for _, st := range wf.GetStatuses() {
+		res.Statuses = append(res.Statuses, domain.WorkflowStatus{
+			Name:     st.GetName(),
+			Category: domain.StatusCategory(st.GetCategory()),
+		})
+	}
+	for _, tr := range wf.GetTransitions() {
+		res.Transitions = append(res.Transitions, domain.WorkflowTransition{From: tr.GetFrom(), To: tr.GetTo()})
 	}
+	return res
 }
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+
+	"task-manager/domain"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type LabelService interface {
+	Create(ctx context.Context, projectID uuid.UUID, name, color string) (*domain.Label, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Label, error)
+	Update(ctx context.Context, id uuid.UUID, name, color *string) (*domain.Label, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type LabelServer struct {
+	taskmanagerpb.UnimplementedLabelServiceServer
+	service LabelService
+}
+
+func NewLabelServer(service LabelService) *LabelServer {
+	return &LabelServer{service: service}
+}
+
+func (s *LabelServer) CreateLabel(
+	ctx context.Context,
+	req *taskmanagerpb.CreateLabelRequest,
+) (*taskmanagerpb.LabelReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	label, err := s.service.Create(ctx, projectID, req.GetName(), req.GetColor())
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "create label failed: %v", err)
+	}
+
+	return &taskmanagerpb.LabelReply{Label: mapLabelToProto(label)}, nil
+}
+
+func (s *LabelServer) UpdateLabel(
+	ctx context.Context,
+	req *taskmanagerpb.UpdateLabelRequest,
+) (*taskmanagerpb.LabelReply, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	var name, color *string
+	if req.GetName() != "" {
+		name = &req.Name
+	}
+	if req.GetColor() != "" {
+		color = &req.Color
+	}
+
+	label, err := s.service.Update(ctx, id, name, color)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "update label failed: %v", err)
+	}
+
+	return &taskmanagerpb.LabelReply{Label: mapLabelToProto(label)}, nil
+}
+
+func (s *LabelServer) DeleteLabel(
+	ctx context.Context,
+	req *taskmanagerpb.DeleteLabelRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	if err := s.service.Delete(ctx, id); err != nil {
+		return nil, status.Errorf(codeForError(err), "delete label failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Label deleted successfully"}, nil
+}
+
+func (s *LabelServer) ListLabels(
+	ctx context.Context,
+	req *taskmanagerpb.ListLabelsRequest,
+) (*taskmanagerpb.ListLabelsReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	labels, err := s.service.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list labels: %v", err)
+	}
+
+	return &taskmanagerpb.ListLabelsReply{Labels: mapLabelsToProto(labels)}, nil
+}
@@ -2,9 +2,9 @@ package middleware
 
 import (
 	"context"
-	"crypto/rsa"
 	"strings"
 
+	"task-manager/pkg/authctx"
 	"task-manager/pkg/jwtutil"
 
 	"google.golang.org/grpc"
@@ -22,12 +22,14 @@ func GetUserIDFromContext(ctx context.Context) (string, bool) {
 	return userID, ok
 }
 
-func NewJWTUnaryInterceptor(publicKey *rsa.PublicKey) grpc.UnaryServerInterceptor {
-	skipAuth := map[string]bool{
-		"/taskmanager.v1.AuthService/Login":    true,
-		"/taskmanager.v1.AuthService/Register": true,
-	}
+var skipAuth = map[string]bool{
+	"/taskmanager.v1.AuthService/Login":        true,
+	"/taskmanager.v1.AuthService/Register":     true,
+	"/taskmanager.v1.AuthService/RefreshToken": true,
+	"/taskmanager.v1.AuthService/Logout":       true,
+}
 
+func NewJWTUnaryInterceptor(validator *jwtutil.Validator) grpc.UnaryServerInterceptor {
 	return func(
 		ctx context.Context,
 		req interface{},
@@ -38,28 +40,70 @@ func NewJWTUnaryInterceptor(publicKey *rsa.PublicKey) grpc.UnaryServerIntercepto
 			return handler(ctx, req)
 		}
 
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
+		ctx, err := authenticate(ctx, validator)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		authHeaders := md["authorization"]
-		if len(authHeaders) == 0 || !strings.HasPrefix(authHeaders[0], "Bearer ") {
-			return nil, status.Error(codes.Unauthenticated, "missing or invalid Authorization header")
+// NewJWTStreamInterceptor authenticates streaming calls the same way
+// NewJWTUnaryInterceptor does unary ones.
+func NewJWTStreamInterceptor(validator *jwtutil.Validator) grpc.StreamServerInterceptor {
+	return func(
+		srv interface{},
+		ss grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if skipAuth[info.FullMethod] {
+			return handler(srv, ss)
 		}
 
-		tokenStr := strings.TrimPrefix(authHeaders[0], "Bearer ")
-		claims, err := jwtutil.ValidateToken(tokenStr, publicKey)
+		ctx, err := authenticate(ss.Context(), validator)
 		if err != nil {
-			return nil, status.Error(codes.Unauthenticated, "invalid token")
+			return err
 		}
+		return handler(srv, &authenticatedStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		userID, ok := claims["sub"].(string)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "invalid subject claim")
-		}
+// authenticate validates the bearer token in the incoming metadata and
+// returns a context carrying the caller's principal.
+func authenticate(ctx context.Context, validator *jwtutil.Validator) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
 
-		ctx = context.WithValue(ctx, userIDKey, userID)
-		return handler(ctx, req)
+	authHeaders := md["authorization"]
+	if len(authHeaders) == 0 || !strings.HasPrefix(authHeaders[0], "Bearer ") {
+		return nil, status.Error(codes.Unauthenticated, "missing or invalid Authorization header")
+	}
+
+	tokenStr := strings.TrimPrefix(authHeaders[0], "Bearer ")
+	claims, err := validator.Validate(tokenStr)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid token: %v", err)
+	}
+
+	principal, err := jwtutil.ParsePrincipal(claims)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "invalid subject claim")
 	}
+
+	ctx = context.WithValue(ctx, userIDKey, principal.UserID.String())
+	ctx = authctx.WithPrincipal(ctx, principal)
+	return ctx, nil
+}
+
+// authenticatedStream hands the authenticated context to stream handlers.
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
 }
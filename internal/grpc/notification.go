@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type NotificationService interface {
+	ListInbox(
+		ctx context.Context,
+		userID uuid.UUID,
+		unreadOnly bool,
+		page pagination.Request,
+	) ([]domain.Notification, string, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
+	Preferences(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error)
+	SetPreferences(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+type NotificationServer struct {
+	taskmanagerpb.UnimplementedNotificationServiceServer
+	service NotificationService
+}
+
+func NewNotificationServer(service NotificationService) *NotificationServer {
+	return &NotificationServer{service: service}
+}
+
+func (s *NotificationServer) ListNotifications(
+	ctx context.Context,
+	req *taskmanagerpb.ListNotificationsRequest,
+) (*taskmanagerpb.ListNotificationsReply, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+	notifications, next, err := s.service.ListInbox(ctx, userID, req.GetUnreadOnly(), page)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list notifications: %v", err)
+	}
+
+	reply := &taskmanagerpb.ListNotificationsReply{NextPageToken: next}
+	for _, n := range notifications {
+		reply.Notifications = append(reply.Notifications, mapNotificationToProto(n))
+	}
+	return reply, nil
+}
+
+func (s *NotificationServer) MarkNotificationsRead(
+	ctx context.Context,
+	req *taskmanagerpb.MarkNotificationsReadRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.GetIds()))
+	for _, raw := range req.GetIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid notification id %q: %v", raw, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := s.service.MarkRead(ctx, userID, ids); err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to mark notifications read: %v", err)
+	}
+	return &taskmanagerpb.SuccessResponse{Message: "Notifications marked read"}, nil
+}
+
+func (s *NotificationServer) GetNotificationPreferences(
+	ctx context.Context,
+	_ *taskmanagerpb.NotificationPreferencesRequest,
+) (*taskmanagerpb.NotificationPreferencesReply, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.service.Preferences(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to get notification preferences: %v", err)
+	}
+	return &taskmanagerpb.NotificationPreferencesReply{Preferences: mapPreferencesToProto(*prefs)}, nil
+}
+
+func (s *NotificationServer) SetNotificationPreferences(
+	ctx context.Context,
+	req *taskmanagerpb.SetNotificationPreferencesRequest,
+) (*taskmanagerpb.NotificationPreferencesReply, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in := req.GetPreferences()
+	prefs := domain.NotificationPreferences{
+		UserID:          userID,
+		Channels:        make(map[domain.NotificationEvent][]domain.NotificationChannel, len(in.GetChannels())),
+		Email:           in.GetEmail(),
+		WebhookURL:      in.GetWebhookUrl(),
+		QuietHoursStart: in.GetQuietHoursStart(),
+		QuietHoursEnd:   in.GetQuietHoursEnd(),
+		Timezone:        in.GetTimezone(),
+	}
+	for event, chs := range in.GetChannels() {
+		channels := make([]domain.NotificationChannel, 0, len(chs.GetChannels()))
+		for _, ch := range chs.GetChannels() {
+			channels = append(channels, domain.NotificationChannel(ch))
+		}
+		prefs.Channels[domain.NotificationEvent(event)] = channels
+	}
+
+	if err := s.service.SetPreferences(ctx, &prefs); err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to set notification preferences: %v", err)
+	}
+	return &taskmanagerpb.NotificationPreferencesReply{Preferences: mapPreferencesToProto(prefs)}, nil
+}
+
+func mapNotificationToProto(n domain.Notification) *taskmanagerpb.Notification {
+	pb := &taskmanagerpb.Notification{
+		Id:        n.ID.String(),
+		Event:     string(n.Event),
+		TaskId:    n.TaskID.String(),
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt.Format(time.RFC3339),
+		ReadAt:    formatOptionalTime(n.ReadAt),
+	}
+	if n.CommentID != nil {
+		pb.CommentId = n.CommentID.String()
+	}
+	if n.ActorID != uuid.Nil {
+		pb.ActorId = n.ActorID.String()
+	}
+	return pb
+}
+
+// mapPreferencesToProto lists the channels of every event, filling in the
+// defaults for events the user has not set.
+func mapPreferencesToProto(p domain.NotificationPreferences) *taskmanagerpb.NotificationPreferences {
+	channels := make(map[string]*taskmanagerpb.NotificationChannels, len(domain.NotificationEvents))
+	for _, event := range domain.NotificationEvents {
+		names := &taskmanagerpb.NotificationChannels{}
+		for _, ch := range p.ChannelsFor(event) {
+			names.Channels = append(names.Channels, string(ch))
+		}
+		channels[string(event)] = names
+	}
+	return &taskmanagerpb.NotificationPreferences{
+		Channels:        channels,
+		Email:           p.Email,
+		WebhookUrl:      p.WebhookURL,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+	}
+}
@@ -2,8 +2,10 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"task-manager/domain"
+	"task-manager/pkg/pagination"
 	taskmanagerpb "task-manager/pkg/pb/taskmanager"
 
 	"github.com/google/uuid"
@@ -12,7 +14,35 @@ import (
 )
 
 type ProjectService interface {
-	ListTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	Create(ctx context.Context, ownerID uuid.UUID, name, key, description string) (*domain.Project, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
+	ListForMember(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]domain.Project, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		name, description *string,
+		archived *bool,
+	) (*domain.Project, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListMembers(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error)
+	AddMember(
+		ctx context.Context,
+		projectID, userID uuid.UUID,
+		role domain.ProjectRole,
+	) (*domain.ProjectMember, error)
+	RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error
+	ListTasks(
+		ctx context.Context,
+		projectID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListBlockedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListTrashedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListOverdueTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListTasksDueThisWeek(ctx context.Context, projectID uuid.UUID, loc *time.Location) ([]domain.Task, error)
+	ListTasksDueBetween(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]domain.Task, error)
 }
 
 type ProjectServer struct {
@@ -24,6 +54,148 @@ func NewProjectServer(service ProjectService) *ProjectServer {
 	return &ProjectServer{service: service}
 }
 
+func (s *ProjectServer) CreateProject(
+	ctx context.Context,
+	req *taskmanagerpb.CreateProjectRequest,
+) (*taskmanagerpb.ProjectReply, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := s.service.Create(ctx, userID, req.GetName(), req.GetKey(), req.GetDescription())
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "create project failed: %v", err)
+	}
+
+	return &taskmanagerpb.ProjectReply{Project: mapProjectToProto(project)}, nil
+}
+
+func (s *ProjectServer) GetProject(
+	ctx context.Context,
+	req *taskmanagerpb.ProjectIdRequest,
+) (*taskmanagerpb.ProjectReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	project, err := s.service.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "get project failed: %v", err)
+	}
+
+	return &taskmanagerpb.ProjectReply{Project: mapProjectToProto(project)}, nil
+}
+
+func (s *ProjectServer) UpdateProject(
+	ctx context.Context,
+	req *taskmanagerpb.UpdateProjectRequest,
+) (*taskmanagerpb.ProjectReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	project, err := s.service.Update(ctx, projectID, req.Name, req.Description, req.Archived)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "update project failed: %v", err)
+	}
+
+	return &taskmanagerpb.ProjectReply{Project: mapProjectToProto(project)}, nil
+}
+
+func (s *ProjectServer) DeleteProject(
+	ctx context.Context,
+	req *taskmanagerpb.ProjectIdRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	if err := s.service.Delete(ctx, projectID); err != nil {
+		return nil, status.Errorf(codeForError(err), "delete project failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Project deleted successfully"}, nil
+}
+
+func (s *ProjectServer) ListMyProjects(
+	ctx context.Context,
+	req *taskmanagerpb.ListMyProjectsRequest,
+) (*taskmanagerpb.ListMyProjectsReply, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	projects, err := s.service.ListForMember(ctx, userID, req.GetIncludeArchived())
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list projects: %v", err)
+	}
+
+	res := &taskmanagerpb.ListMyProjectsReply{}
+	for _, p := range projects {
+		res.Projects = append(res.Projects, mapProjectToProto(&p))
+	}
+	return res, nil
+}
+
+func (s *ProjectServer) ListProjectMembers(
+	ctx context.Context,
+	req *taskmanagerpb.ProjectIdRequest,
+) (*taskmanagerpb.ListProjectMembersReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	members, err := s.service.ListMembers(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list project members: %v", err)
+	}
+
+	res := &taskmanagerpb.ListProjectMembersReply{}
+	for _, m := range members {
+		res.Members = append(res.Members, mapProjectMemberToProto(&m))
+	}
+	return res, nil
+}
+
+func (s *ProjectServer) AddProjectMember(
+	ctx context.Context,
+	req *taskmanagerpb.AddProjectMemberRequest,
+) (*taskmanagerpb.ProjectMemberReply, error) {
+	projectID, userID, err := parseProjectMemberRef(req.GetProjectId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := s.service.AddMember(ctx, projectID, userID, domain.ProjectRole(req.GetRole()))
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "add project member failed: %v", err)
+	}
+
+	return &taskmanagerpb.ProjectMemberReply{Member: mapProjectMemberToProto(member)}, nil
+}
+
+func (s *ProjectServer) RemoveProjectMember(
+	ctx context.Context,
+	req *taskmanagerpb.RemoveProjectMemberRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	projectID, userID, err := parseProjectMemberRef(req.GetProjectId(), req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.service.RemoveMember(ctx, projectID, userID); err != nil {
+		return nil, status.Errorf(codeForError(err), "remove project member failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Member removed successfully"}, nil
+}
+
 func (s *ProjectServer) GetTasks(
 	ctx context.Context,
 	req *taskmanagerpb.GetProjectTasksRequest,
@@ -33,17 +205,170 @@ func (s *ProjectServer) GetTasks(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
 	}
 
-	tasks, err := s.service.ListTasks(ctx, projectID)
+	filter, err := parseTaskFilter(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list project tasks: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if raw := req.GetAssigneeId(); raw != "" {
+		assigneeID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assignee_id: %v", err)
+		}
+		filter.AssigneeID = &assigneeID
 	}
 
-	var protoTasks []*taskmanagerpb.Task
-	for _, task := range tasks {
-		protoTasks = append(protoTasks, mapTaskToProto(&task))
+	sort, err := parseTaskSort(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, next, err := s.service.ListTasks(ctx, projectID, filter, sort, parseTaskPage(req))
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list project tasks: %v", err)
 	}
 
 	return &taskmanagerpb.GetProjectTasksReply{
-		Tasks: protoTasks,
+		Tasks:         mapTasksToProto(tasks),
+		NextPageToken: next,
 	}, nil
 }
+
+func (s *ProjectServer) GetBlockedTasks(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectTasksRequest,
+) (*taskmanagerpb.GetProjectTasksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	tasks, err := s.service.ListBlockedTasks(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list blocked tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetProjectTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *ProjectServer) GetTrashedTasks(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectTasksRequest,
+) (*taskmanagerpb.GetProjectTasksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	tasks, err := s.service.ListTrashedTasks(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list trashed tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetProjectTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *ProjectServer) GetOverdueTasks(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectTasksRequest,
+) (*taskmanagerpb.GetProjectTasksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	tasks, err := s.service.ListOverdueTasks(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list overdue tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetProjectTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *ProjectServer) GetTasksDueThisWeek(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectTasksDueThisWeekRequest,
+) (*taskmanagerpb.GetProjectTasksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	loc, err := parseLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time_zone: %v", err)
+	}
+
+	tasks, err := s.service.ListTasksDueThisWeek(ctx, projectID, loc)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list tasks due this week: %v", err)
+	}
+
+	return &taskmanagerpb.GetProjectTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *ProjectServer) GetTasksDueBetween(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectTasksDueBetweenRequest,
+) (*taskmanagerpb.GetProjectTasksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	from, to, err := parseTimeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, err := s.service.ListTasksDueBetween(ctx, projectID, from, to)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list due tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetProjectTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func parseProjectMemberRef(rawProjectID, rawUserID string) (uuid.UUID, uuid.UUID, error) {
+	projectID, err := uuid.Parse(rawProjectID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+	userID, err := uuid.Parse(rawUserID)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+	return projectID, userID, nil
+}
+
+func mapProjectToProto(p *domain.Project) *taskmanagerpb.Project {
+	return &taskmanagerpb.Project{
+		Id:          p.ID.String(),
+		Name:        p.Name,
+		Key:         p.Key,
+		Description: p.Description,
+		OwnerId:     p.OwnerID.String(),
+		Archived:    p.Archived,
+		CreatedAt:   p.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:   p.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+func mapProjectMemberToProto(m *domain.ProjectMember) *taskmanagerpb.ProjectMember {
+	return &taskmanagerpb.ProjectMember{
+		ProjectId: m.ProjectID.String(),
+		UserId:    m.UserID.String(),
+		Role:      string(m.Role),
+		CreatedAt: m.CreatedAt.Format(time.RFC3339),
+	}
+}
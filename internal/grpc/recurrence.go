@@ -0,0 +1,107 @@
+package grpc
+
+import (
+	"context"
+
+	"task-manager/domain"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RecurrenceService interface {
+	SetRecurrence(ctx context.Context, taskID uuid.UUID, rule, timezone string) (*domain.Series, error)
+	Recurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error)
+	StopRecurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error)
+	UpdateSeries(ctx context.Context, task *domain.Task) error
+}
+
+type RecurrenceServer struct {
+	taskmanagerpb.UnimplementedRecurrenceServiceServer
+	service RecurrenceService
+	tasks   TaskService
+}
+
+func NewRecurrenceServer(service RecurrenceService, tasks TaskService) *RecurrenceServer {
+	return &RecurrenceServer{service: service, tasks: tasks}
+}
+
+func (s *RecurrenceServer) SetTaskRecurrence(
+	ctx context.Context,
+	req *taskmanagerpb.SetTaskRecurrenceRequest,
+) (*taskmanagerpb.SeriesReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	series, err := s.service.SetRecurrence(ctx, taskID, req.GetRrule(), req.GetTimezone())
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to set recurrence: %v", err)
+	}
+
+	return &taskmanagerpb.SeriesReply{Series: mapSeriesToProto(series)}, nil
+}
+
+func (s *RecurrenceServer) GetTaskRecurrence(
+	ctx context.Context,
+	req *taskmanagerpb.TaskRecurrenceRequest,
+) (*taskmanagerpb.SeriesReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	series, err := s.service.Recurrence(ctx, taskID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to get recurrence: %v", err)
+	}
+
+	return &taskmanagerpb.SeriesReply{Series: mapSeriesToProto(series)}, nil
+}
+
+func (s *RecurrenceServer) StopTaskRecurrence(
+	ctx context.Context,
+	req *taskmanagerpb.TaskRecurrenceRequest,
+) (*taskmanagerpb.SeriesReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	series, err := s.service.StopRecurrence(ctx, taskID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to stop recurrence: %v", err)
+	}
+
+	return &taskmanagerpb.SeriesReply{Series: mapSeriesToProto(series)}, nil
+}
+
+func (s *RecurrenceServer) UpdateTaskSeries(
+	ctx context.Context,
+	req *taskmanagerpb.UpdateTaskRequest,
+) (*taskmanagerpb.UpdateTaskReply, error) {
+	id, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	task, err := s.tasks.GetByID(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "task not found: %v", err)
+	}
+	if req.GetExpectedVersion() != 0 {
+		task.Version = req.GetExpectedVersion()
+	}
+	if err := applyTaskUpdate(task, req); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.UpdateSeries(ctx, task); err != nil {
+		return nil, status.Errorf(codeForError(err), "update series failed: %v", err)
+	}
+
+	return &taskmanagerpb.UpdateTaskReply{Task: mapTaskToProto(task)}, nil
+}
@@ -0,0 +1,61 @@
+package grpc
+
+import (
+	"context"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, query domain.SearchQuery, page pagination.Request) ([]domain.SearchHit, string, error)
+}
+
+type SearchServer struct {
+	taskmanagerpb.UnimplementedSearchServiceServer
+	service SearchService
+}
+
+func NewSearchServer(service SearchService) *SearchServer {
+	return &SearchServer{service: service}
+}
+
+func (s *SearchServer) Search(
+	ctx context.Context,
+	req *taskmanagerpb.SearchRequest,
+) (*taskmanagerpb.SearchReply, error) {
+	query := domain.SearchQuery{
+		Text:     req.GetQuery(),
+		Statuses: req.GetStatuses(),
+	}
+	if raw := req.GetProjectId(); raw != "" {
+		projectID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+		}
+		query.ProjectID = &projectID
+	}
+	if raw := req.GetAssigneeId(); raw != "" {
+		assigneeID, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid assignee_id: %v", err)
+		}
+		query.AssigneeID = &assigneeID
+	}
+
+	page := pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+	hits, next, err := s.service.Search(ctx, query, page)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "search failed: %v", err)
+	}
+
+	return &taskmanagerpb.SearchReply{
+		Hits:          mapSearchHitsToProto(hits),
+		NextPageToken: next,
+	}, nil
+}
@@ -10,15 +10,26 @@ type Server = grpc.Server
 
 func NewServer(
 	jwtInterceptor grpc.UnaryServerInterceptor,
+	jwtStreamInterceptor grpc.StreamServerInterceptor,
 	authSvc AuthService,
 	taskSvc TaskService,
 	userSvc UserService,
 	projectSvc ProjectService,
+	labelSvc LabelService,
+	searchSvc SearchService,
+	attachmentSvc AttachmentService,
+	recurrenceSvc RecurrenceService,
+	notificationSvc NotificationService,
+	webhookSvc WebhookService,
+	taskWatcher TaskWatcher,
 ) *Server {
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			jwtInterceptor,
 		),
+		grpc.ChainStreamInterceptor(
+			jwtStreamInterceptor,
+		),
 	)
 
 	reflection.Register(grpcServer)
@@ -27,6 +38,13 @@ func NewServer(
 	taskmanagerpb.RegisterTaskServiceServer(grpcServer, NewTaskServer(taskSvc))
 	taskmanagerpb.RegisterUserServiceServer(grpcServer, NewUserServer(userSvc))
 	taskmanagerpb.RegisterProjectServiceServer(grpcServer, NewProjectServer(projectSvc))
+	taskmanagerpb.RegisterLabelServiceServer(grpcServer, NewLabelServer(labelSvc))
+	taskmanagerpb.RegisterSearchServiceServer(grpcServer, NewSearchServer(searchSvc))
+	taskmanagerpb.RegisterAttachmentServiceServer(grpcServer, NewAttachmentServer(attachmentSvc))
+	taskmanagerpb.RegisterRecurrenceServiceServer(grpcServer, NewRecurrenceServer(recurrenceSvc, taskSvc))
+	taskmanagerpb.RegisterNotificationServiceServer(grpcServer, NewNotificationServer(notificationSvc))
+	taskmanagerpb.RegisterWebhookServiceServer(grpcServer, NewWebhookServer(webhookSvc))
+	taskmanagerpb.RegisterWatchServiceServer(grpcServer, NewWatchServer(taskWatcher, projectSvc))
 
 	return grpcServer
 }
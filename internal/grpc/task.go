@@ -6,6 +6,7 @@ import (
 
 	"task-manager/domain"
 	"task-manager/internal/grpc/middleware"
+	"task-manager/pkg/pagination"
 	taskmanagerpb "task-manager/pkg/pb/taskmanager"
 
 	"github.com/google/uuid"
@@ -17,9 +18,22 @@ type TaskService interface {
 	Create(ctx context.Context, task *domain.Task) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
 	Update(ctx context.Context, task *domain.Task) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id, deletedBy uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+	ListActivity(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Activity, string, error)
 	Assign(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error
-	Comment(ctx context.Context, taskID, userID uuid.UUID, content string) error
+	Comment(ctx context.Context, taskID, userID uuid.UUID, content string, parentID *uuid.UUID) (*domain.Comment, error)
+	ListComments(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Comment, string, error)
+	EditComment(ctx context.Context, taskID, commentID, userID uuid.UUID, content string) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, taskID, commentID, userID uuid.UUID) error
+	ListSubtasks(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error)
+	AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+	RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+	ListDependencies(ctx context.Context, taskID uuid.UUID) (*domain.TaskDependencies, error)
+	Workflow(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error)
+	SetWorkflow(ctx context.Context, workflow *domain.Workflow) error
+	AttachLabel(ctx context.Context, taskID, labelID uuid.UUID) error
+	DetachLabel(ctx context.Context, taskID, labelID uuid.UUID) error
 }
 
 type TaskServer struct {
@@ -40,6 +54,21 @@ func (s *TaskServer) CreateTask(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
 	}
 
+	startDate, err := parseOptionalTime(req.GetStartDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid start_date: %v", err)
+	}
+
+	dueDate, err := parseOptionalTime(req.GetDueDate())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid due_date: %v", err)
+	}
+
+	parentID, err := parseOptionalUUID(req.GetParentId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid parent_id: %v", err)
+	}
+
 	userIDStr, ok := middleware.GetUserIDFromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Unauthenticated, "user ID not found in context")
@@ -55,14 +84,18 @@ func (s *TaskServer) CreateTask(
 		Title:       req.GetTitle(),
 		Description: req.GetDescription(),
 		Status:      req.GetStatus(),
+		Priority:    mapPriorityFromProto(req.GetPriority()),
 		ProjectID:   projectID,
+		ParentID:    parentID,
 		AssignedTo:  userID,
+		StartDate:   startDate,
+		DueDate:     dueDate,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
 	if err := s.service.Create(ctx, task); err != nil {
-		return nil, status.Errorf(codes.Internal, "create task failed: %v", err)
+		return nil, status.Errorf(codeForError(err), "create task failed: %v", err)
 	}
 
 	return &taskmanagerpb.CreateTaskReply{
@@ -81,7 +114,7 @@ func (s *TaskServer) GetTaskByID(ctx context.Context, req *taskmanagerpb.GetTask
 
 	task, err := s.service.GetByID(ctx, id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "get task failed: %v", err)
+		return nil, status.Errorf(codeForError(err), "get task failed: %v", err)
 	}
 
 	return &taskmanagerpb.GetTaskReply{
@@ -98,16 +131,21 @@ func (s *TaskServer) UpdateTaskByID(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
 	}
 
-	task := &domain.Task{
-		ID:          id,
-		Title:       req.GetTitle(),
-		Description: req.GetDescription(),
-		Status:      req.GetStatus(),
-		UpdatedAt:   time.Now(),
+	task, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "get task failed: %v", err)
+	}
+	if req.GetExpectedVersion() != 0 {
+		task.Version = req.GetExpectedVersion()
+	}
+
+	if err := applyTaskUpdate(task, req); err != nil {
+		return nil, err
 	}
+	task.UpdatedAt = time.Now()
 
 	if err := s.service.Update(ctx, task); err != nil {
-		return nil, status.Errorf(codes.Internal, "update task failed: %v", err)
+		return nil, status.Errorf(codeForError(err), "update task failed: %v", err)
 	}
 
 	return &taskmanagerpb.UpdateTaskReply{
@@ -115,6 +153,40 @@ func (s *TaskServer) UpdateTaskByID(
 	}, nil
 }
 
+// applyTaskUpdate copies the fields set in req onto task. Empty fields are
+// left unchanged.
+func applyTaskUpdate(task *domain.Task, req *taskmanagerpb.UpdateTaskRequest) error {
+	var err error
+	if req.GetTitle() != "" {
+		task.Title = req.GetTitle()
+	}
+	if req.GetDescription() != "" {
+		task.Description = req.GetDescription()
+	}
+	if req.GetStatus() != "" {
+		task.Status = req.GetStatus()
+	}
+	if req.GetPriority() != taskmanagerpb.TaskPriority_TASK_PRIORITY_UNSPECIFIED {
+		task.Priority = mapPriorityFromProto(req.GetPriority())
+	}
+	if req.GetParentId() != "" {
+		if task.ParentID, err = parseOptionalUUID(req.GetParentId()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid parent_id: %v", err)
+		}
+	}
+	if req.GetStartDate() != "" {
+		if task.StartDate, err = parseOptionalTime(req.GetStartDate()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid start_date: %v", err)
+		}
+	}
+	if req.GetDueDate() != "" {
+		if task.DueDate, err = parseOptionalTime(req.GetDueDate()); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid due_date: %v", err)
+		}
+	}
+	return nil
+}
+
 func (s *TaskServer) DeleteTaskByID(
 	ctx context.Context,
 	req *taskmanagerpb.DeleteTaskRequest,
@@ -124,13 +196,40 @@ func (s *TaskServer) DeleteTaskByID(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
 	}
 
-	if err := s.service.Delete(ctx, id); err != nil {
-		return nil, status.Errorf(codes.Internal, "delete task failed: %v", err)
+	userIDStr, ok := middleware.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "user ID not found in context")
+	}
+
+	userID, err := uuid.Parse(userIDStr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+	}
+
+	if err := s.service.Delete(ctx, id, userID); err != nil {
+		return nil, status.Errorf(codeForError(err), "delete task failed: %v", err)
 	}
 
 	return &taskmanagerpb.SuccessResponse{Message: "Task deleted successfully"}, nil
 }
 
+func (s *TaskServer) RestoreTask(
+	ctx context.Context,
+	req *taskmanagerpb.RestoreTaskRequest,
+) (*taskmanagerpb.RestoreTaskReply, error) {
+	id, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	task, err := s.service.Restore(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "restore task failed: %v", err)
+	}
+
+	return &taskmanagerpb.RestoreTaskReply{Task: mapTaskToProto(task)}, nil
+}
+
 func (s *TaskServer) AssignTaskToUser(
 	ctx context.Context,
 	req *taskmanagerpb.AssignTaskRequest,
@@ -146,34 +245,184 @@ func (s *TaskServer) AssignTaskToUser(
 	}
 
 	if err := s.service.Assign(ctx, taskID, userID); err != nil {
-		return nil, status.Errorf(codes.Internal, "assign failed: %v", err)
+		return nil, status.Errorf(codeForError(err), "assign failed: %v", err)
 	}
 
 	return &taskmanagerpb.SuccessResponse{Message: "Task assigned successfully"}, nil
 }
 
-func (s *TaskServer) CommentOnTask(
+func (s *TaskServer) ListSubtasks(
 	ctx context.Context,
-	req *taskmanagerpb.CommentTaskRequest,
+	req *taskmanagerpb.ListSubtasksRequest,
+) (*taskmanagerpb.ListSubtasksReply, error) {
+	parentID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	tasks, err := s.service.ListSubtasks(ctx, parentID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list subtasks failed: %v", err)
+	}
+
+	return &taskmanagerpb.ListSubtasksReply{Tasks: mapTasksToProto(tasks)}, nil
+}
+
+func (s *TaskServer) AddTaskDependency(
+	ctx context.Context,
+	req *taskmanagerpb.TaskDependencyRequest,
 ) (*taskmanagerpb.SuccessResponse, error) {
 	taskID, err := uuid.Parse(req.GetTaskId())
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
 	}
 
-	userIDStr, ok := middleware.GetUserIDFromContext(ctx)
-	if !ok {
-		return nil, status.Error(codes.Unauthenticated, "user ID not found in context")
+	blockerID, err := uuid.Parse(req.GetBlockerId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blocker_id: %v", err)
 	}
 
-	userID, err := uuid.Parse(userIDStr)
+	if err := s.service.AddDependency(ctx, taskID, blockerID); err != nil {
+		return nil, status.Errorf(codeForError(err), "add dependency failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Dependency added successfully"}, nil
+}
+
+func (s *TaskServer) RemoveTaskDependency(
+	ctx context.Context,
+	req *taskmanagerpb.TaskDependencyRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
 	if err != nil {
-		return nil, status.Error(codes.InvalidArgument, "invalid user ID format")
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	blockerID, err := uuid.Parse(req.GetBlockerId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid blocker_id: %v", err)
+	}
+
+	if err := s.service.RemoveDependency(ctx, taskID, blockerID); err != nil {
+		return nil, status.Errorf(codeForError(err), "remove dependency failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Dependency removed successfully"}, nil
+}
+
+func (s *TaskServer) ListTaskDependencies(
+	ctx context.Context,
+	req *taskmanagerpb.ListTaskDependenciesRequest,
+) (*taskmanagerpb.ListTaskDependenciesReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	deps, err := s.service.ListDependencies(ctx, taskID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list dependencies failed: %v", err)
+	}
+
+	return &taskmanagerpb.ListTaskDependenciesReply{
+		BlockedBy: mapTasksToProto(deps.BlockedBy),
+		Blocks:    mapTasksToProto(deps.Blocks),
+	}, nil
+}
+
+func (s *TaskServer) GetProjectWorkflow(
+	ctx context.Context,
+	req *taskmanagerpb.GetProjectWorkflowRequest,
+) (*taskmanagerpb.WorkflowReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	wf, err := s.service.Workflow(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "get workflow failed: %v", err)
+	}
+
+	return &taskmanagerpb.WorkflowReply{Workflow: mapWorkflowToProto(wf)}, nil
+}
+
+func (s *TaskServer) SetProjectWorkflow(
+	ctx context.Context,
+	req *taskmanagerpb.SetProjectWorkflowRequest,
+) (*taskmanagerpb.WorkflowReply, error) {
+	projectID, err := uuid.Parse(req.GetWorkflow().GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	wf := mapWorkflowFromProto(projectID, req.GetWorkflow())
+	if err := s.service.SetWorkflow(ctx, wf); err != nil {
+		return nil, status.Errorf(codeForError(err), "set workflow failed: %v", err)
+	}
+
+	return &taskmanagerpb.WorkflowReply{Workflow: mapWorkflowToProto(wf)}, nil
+}
+
+func (s *TaskServer) AttachLabel(
+	ctx context.Context,
+	req *taskmanagerpb.TaskLabelRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
 	}
 
-	if err := s.service.Comment(ctx, taskID, userID, req.GetContent()); err != nil {
-		return nil, status.Errorf(codes.Internal, "comment failed: %v", err)
+	labelID, err := uuid.Parse(req.GetLabelId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label_id: %v", err)
 	}
 
-	return &taskmanagerpb.SuccessResponse{Message: "Comment added successfully"}, nil
+	if err := s.service.AttachLabel(ctx, taskID, labelID); err != nil {
+		return nil, status.Errorf(codeForError(err), "attach label failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Label attached successfully"}, nil
+}
+
+func (s *TaskServer) DetachLabel(
+	ctx context.Context,
+	req *taskmanagerpb.TaskLabelRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	labelID, err := uuid.Parse(req.GetLabelId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid label_id: %v", err)
+	}
+
+	if err := s.service.DetachLabel(ctx, taskID, labelID); err != nil {
+		return nil, status.Errorf(codeForError(err), "detach label failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Label detached successfully"}, nil
+}
+
+func (s *TaskServer) ListTaskActivity(
+	ctx context.Context,
+	req *taskmanagerpb.ListTaskActivityRequest,
+) (*taskmanagerpb.ListTaskActivityReply, error) {
+	taskID, err := uuid.Parse(req.GetTaskId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task_id: %v", err)
+	}
+
+	page := pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+	activities, next, err := s.service.ListActivity(ctx, taskID, page)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list activity failed: %v", err)
+	}
+
+	return &taskmanagerpb.ListTaskActivityReply{
+		Activities:    mapActivitiesToProto(activities),
+		NextPageToken: next,
+	}, nil
 }
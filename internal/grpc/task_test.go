@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"task-manager/domain"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// assignOnly is a TaskService that only implements Assign.
+type assignOnly struct {
+	TaskService
+	err error
+}
+
+func (s assignOnly) Assign(context.Context, uuid.UUID, uuid.UUID) error { return s.err }
+
+func TestAssignTask_VersionConflict(t *testing.T) {
+	server := NewTaskServer(assignOnly{err: fmt.Errorf("%w: task was modified", domain.ErrVersionConflict)})
+
+	_, err := server.AssignTaskToUser(context.Background(), &taskmanagerpb.AssignTaskRequest{
+		TaskId: uuid.NewString(),
+		UserId: uuid.NewString(),
+	})
+	assert.Equal(t, codes.Aborted, status.Code(err))
+}
@@ -2,8 +2,10 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"task-manager/domain"
+	"task-manager/pkg/pagination"
 	taskmanagerpb "task-manager/pkg/pb/taskmanager"
 
 	"github.com/google/uuid"
@@ -12,7 +14,16 @@ import (
 )
 
 type UserService interface {
-	ListTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error)
+	ListTasks(
+		ctx context.Context,
+		userID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListOverdueTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error)
+	ListTasksDueThisWeek(ctx context.Context, userID uuid.UUID, loc *time.Location) ([]domain.Task, error)
+	ListTasksDueBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.Task, error)
 }
 
 type UserServer struct {
@@ -33,17 +44,90 @@ func (s *UserServer) GetTasks(
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
 	}
 
-	tasks, err := s.service.ListTasks(ctx, userID)
+	filter, err := parseTaskFilter(req)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list user tasks: %v", err)
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	var protoTasks []*taskmanagerpb.Task
-	for _, task := range tasks {
-		protoTasks = append(protoTasks, mapTaskToProto(&task))
+	sort, err := parseTaskSort(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, next, err := s.service.ListTasks(ctx, userID, filter, sort, parseTaskPage(req))
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list user tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetUserTasksReply{
+		Tasks:         mapTasksToProto(tasks),
+		NextPageToken: next,
+	}, nil
+}
+
+func (s *UserServer) GetOverdueTasks(
+	ctx context.Context,
+	req *taskmanagerpb.GetUserTasksRequest,
+) (*taskmanagerpb.GetUserTasksReply, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	tasks, err := s.service.ListOverdueTasks(ctx, userID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list overdue tasks: %v", err)
+	}
+
+	return &taskmanagerpb.GetUserTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *UserServer) GetTasksDueThisWeek(
+	ctx context.Context,
+	req *taskmanagerpb.GetUserTasksDueThisWeekRequest,
+) (*taskmanagerpb.GetUserTasksReply, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	loc, err := parseLocation(req.GetTimeZone())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid time_zone: %v", err)
+	}
+
+	tasks, err := s.service.ListTasksDueThisWeek(ctx, userID, loc)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list tasks due this week: %v", err)
+	}
+
+	return &taskmanagerpb.GetUserTasksReply{
+		Tasks: mapTasksToProto(tasks),
+	}, nil
+}
+
+func (s *UserServer) GetTasksDueBetween(
+	ctx context.Context,
+	req *taskmanagerpb.GetUserTasksDueBetweenRequest,
+) (*taskmanagerpb.GetUserTasksReply, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	from, to, err := parseTimeRange(req.GetFrom(), req.GetTo())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tasks, err := s.service.ListTasksDueBetween(ctx, userID, from, to)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "failed to list due tasks: %v", err)
 	}
 
 	return &taskmanagerpb.GetUserTasksReply{
-		Tasks: protoTasks,
+		Tasks: mapTasksToProto(tasks),
 	}, nil
 }
@@ -0,0 +1,149 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-manager/domain"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// resetEvent tells a client that it missed events and should reload.
+	resetEvent = "reset"
+	// accessCheckInterval is how often a project stream checks that the
+	// caller may still read the project, so that removed members stop
+	// receiving events.
+	accessCheckInterval = time.Minute
+)
+
+type TaskWatcher interface {
+	WatchProject(
+		projectID uuid.UUID,
+		afterSequence int64,
+	) (events <-chan domain.TaskEvent, missed bool, cancel func())
+	WatchAssignee(
+		userID uuid.UUID,
+		afterSequence int64,
+	) (events <-chan domain.TaskEvent, missed bool, cancel func())
+}
+
+type WatchServer struct {
+	taskmanagerpb.UnimplementedWatchServiceServer
+	watcher  TaskWatcher
+	projects ProjectService
+}
+
+func NewWatchServer(watcher TaskWatcher, projects ProjectService) *WatchServer {
+	return &WatchServer{watcher: watcher, projects: projects}
+}
+
+func (s *WatchServer) WatchProjectTasks(
+	req *taskmanagerpb.WatchProjectTasksRequest,
+	stream taskmanagerpb.WatchService_WatchProjectTasksServer,
+) error {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+	if req.GetAfterSequence() < 0 {
+		return status.Error(codes.InvalidArgument, "after_sequence must not be negative")
+	}
+	// Only those who may read the project may follow it.
+	if _, err := s.projects.GetByID(stream.Context(), projectID); err != nil {
+		return status.Errorf(codeForError(err), "watch project failed: %v", err)
+	}
+
+	events, missed, cancel := s.watcher.WatchProject(projectID, req.GetAfterSequence())
+	defer cancel()
+	return forwardTaskEvents(stream, events, missed, func(ctx context.Context) error {
+		_, err := s.projects.GetByID(ctx, projectID)
+		return err
+	})
+}
+
+func (s *WatchServer) WatchMyTasks(
+	req *taskmanagerpb.WatchMyTasksRequest,
+	stream taskmanagerpb.WatchService_WatchMyTasksServer,
+) error {
+	userID, err := callerID(stream.Context())
+	if err != nil {
+		return err
+	}
+	if req.GetAfterSequence() < 0 {
+		return status.Error(codes.InvalidArgument, "after_sequence must not be negative")
+	}
+
+	events, missed, cancel := s.watcher.WatchAssignee(userID, req.GetAfterSequence())
+	defer cancel()
+	return forwardTaskEvents(stream, events, missed, nil)
+}
+
+// forwardTaskEvents sends the events to the client until it goes away or
+// falls behind. When canRead is set, it is called every accessCheckInterval
+// and the stream ends once it refuses the caller; other errors are retried
+// on the next check.
+func forwardTaskEvents(
+	stream taskmanagerpb.WatchService_WatchProjectTasksServer,
+	events <-chan domain.TaskEvent,
+	missed bool,
+	canRead func(ctx context.Context) error,
+) error {
+	if missed {
+		if err := stream.Send(&taskmanagerpb.TaskEvent{Type: resetEvent}); err != nil {
+			return err
+		}
+	}
+
+	var accessCheck <-chan time.Time
+	if canRead != nil {
+		ticker := time.NewTicker(accessCheckInterval)
+		defer ticker.Stop()
+		accessCheck = ticker.C
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-accessCheck:
+			err := canRead(stream.Context())
+			if errors.Is(err, domain.ErrForbidden) || errors.Is(err, domain.ErrNotFound) {
+				return status.Errorf(codes.PermissionDenied, "access revoked: %v", err)
+			}
+		case e, open := <-events:
+			if !open {
+				return status.Error(codes.Unavailable, "too far behind; reconnect with after_sequence")
+			}
+			if err := stream.Send(mapTaskEventToProto(&e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func mapTaskEventToProto(e *domain.TaskEvent) *taskmanagerpb.TaskEvent {
+	res := &taskmanagerpb.TaskEvent{
+		Id:         e.ID.String(),
+		Sequence:   e.Sequence,
+		Type:       string(e.Type),
+		ProjectId:  e.ProjectID.String(),
+		OccurredAt: e.OccurredAt.Format(time.RFC3339),
+		Task:       mapTaskToProto(&e.Task),
+	}
+	if e.ActorID != uuid.Nil {
+		res.ActorId = e.ActorID.String()
+	}
+	if e.Comment != nil {
+		res.Comment = mapCommentToProto(e.Comment)
+	}
+	for _, c := range e.Changes {
+		res.Changes = append(res.Changes, &taskmanagerpb.FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+	return res
+}
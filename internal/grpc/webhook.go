@@ -0,0 +1,276 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	taskmanagerpb "task-manager/pkg/pb/taskmanager"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type WebhookService interface {
+	Create(
+		ctx context.Context,
+		projectID uuid.UUID,
+		url, secret string,
+		events []domain.TaskEventType,
+	) (*domain.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		url, secret *string,
+		events []domain.TaskEventType,
+		active *bool,
+	) (*domain.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(
+		ctx context.Context,
+		webhookID uuid.UUID,
+		page pagination.Request,
+	) ([]domain.WebhookDelivery, string, error)
+	Delivery(ctx context.Context, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, []domain.WebhookAttempt, error)
+	Replay(ctx context.Context, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error)
+}
+
+type WebhookServer struct {
+	taskmanagerpb.UnimplementedWebhookServiceServer
+	service WebhookService
+}
+
+func NewWebhookServer(service WebhookService) *WebhookServer {
+	return &WebhookServer{service: service}
+}
+
+func (s *WebhookServer) CreateWebhook(
+	ctx context.Context,
+	req *taskmanagerpb.CreateWebhookRequest,
+) (*taskmanagerpb.WebhookReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	hook, err := s.service.Create(ctx, projectID, req.GetUrl(), req.GetSecret(), eventTypes(req.GetEvents()))
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "create webhook failed: %v", err)
+	}
+
+	pb := mapWebhookToProto(hook)
+	pb.Secret = hook.Secret
+	return &taskmanagerpb.WebhookReply{Webhook: pb}, nil
+}
+
+func (s *WebhookServer) ListWebhooks(
+	ctx context.Context,
+	req *taskmanagerpb.ListWebhooksRequest,
+) (*taskmanagerpb.ListWebhooksReply, error) {
+	projectID, err := uuid.Parse(req.GetProjectId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid project_id: %v", err)
+	}
+
+	hooks, err := s.service.ListByProject(ctx, projectID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list webhooks failed: %v", err)
+	}
+
+	reply := &taskmanagerpb.ListWebhooksReply{}
+	for i := range hooks {
+		reply.Webhooks = append(reply.Webhooks, mapWebhookToProto(&hooks[i]))
+	}
+	return reply, nil
+}
+
+func (s *WebhookServer) GetWebhook(
+	ctx context.Context,
+	req *taskmanagerpb.WebhookIdRequest,
+) (*taskmanagerpb.WebhookReply, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	hook, err := s.service.GetByID(ctx, id)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "get webhook failed: %v", err)
+	}
+
+	return &taskmanagerpb.WebhookReply{Webhook: mapWebhookToProto(hook)}, nil
+}
+
+func (s *WebhookServer) UpdateWebhook(
+	ctx context.Context,
+	req *taskmanagerpb.UpdateWebhookRequest,
+) (*taskmanagerpb.WebhookReply, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	var url, secret *string
+	if req.GetUrl() != "" {
+		url = &req.Url
+	}
+	if req.GetSecret() != "" {
+		secret = &req.Secret
+	}
+	var events []domain.TaskEventType
+	if len(req.GetEvents()) > 0 {
+		events = eventTypes(req.GetEvents())
+	}
+
+	hook, err := s.service.Update(ctx, id, url, secret, events, req.Active)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "update webhook failed: %v", err)
+	}
+
+	return &taskmanagerpb.WebhookReply{Webhook: mapWebhookToProto(hook)}, nil
+}
+
+func (s *WebhookServer) DeleteWebhook(
+	ctx context.Context,
+	req *taskmanagerpb.WebhookIdRequest,
+) (*taskmanagerpb.SuccessResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	if err := s.service.Delete(ctx, id); err != nil {
+		return nil, status.Errorf(codeForError(err), "delete webhook failed: %v", err)
+	}
+
+	return &taskmanagerpb.SuccessResponse{Message: "Webhook deleted"}, nil
+}
+
+func (s *WebhookServer) ListWebhookDeliveries(
+	ctx context.Context,
+	req *taskmanagerpb.ListWebhookDeliveriesRequest,
+) (*taskmanagerpb.ListWebhookDeliveriesReply, error) {
+	webhookID, err := uuid.Parse(req.GetWebhookId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid webhook_id: %v", err)
+	}
+
+	page := pagination.Request{Limit: int(req.GetPageSize()), Cursor: req.GetPageToken()}
+	deliveries, next, err := s.service.ListDeliveries(ctx, webhookID, page)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "list webhook deliveries failed: %v", err)
+	}
+
+	reply := &taskmanagerpb.ListWebhookDeliveriesReply{NextPageToken: next}
+	for _, d := range deliveries {
+		reply.Deliveries = append(reply.Deliveries, mapWebhookDeliveryToProto(d))
+	}
+	return reply, nil
+}
+
+func (s *WebhookServer) GetWebhookDelivery(
+	ctx context.Context,
+	req *taskmanagerpb.WebhookDeliveryRequest,
+) (*taskmanagerpb.WebhookDeliveryReply, error) {
+	webhookID, deliveryID, err := parseDeliveryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery, attempts, err := s.service.Delivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "get webhook delivery failed: %v", err)
+	}
+
+	reply := &taskmanagerpb.WebhookDeliveryReply{
+		Delivery: mapWebhookDeliveryToProto(*delivery),
+		Payload:  delivery.Payload,
+	}
+	for _, a := range attempts {
+		reply.AttemptLog = append(reply.AttemptLog, &taskmanagerpb.WebhookAttempt{
+			Attempt:     int32(a.Attempt),
+			StatusCode:  int32(a.StatusCode),
+			Error:       a.Error,
+			DurationMs:  a.Duration.Milliseconds(),
+			AttemptedAt: a.AttemptedAt.Format(time.RFC3339),
+		})
+	}
+	return reply, nil
+}
+
+func (s *WebhookServer) ReplayWebhookDelivery(
+	ctx context.Context,
+	req *taskmanagerpb.WebhookDeliveryRequest,
+) (*taskmanagerpb.WebhookDelivery, error) {
+	webhookID, deliveryID, err := parseDeliveryRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := s.service.Replay(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, status.Errorf(codeForError(err), "replay webhook delivery failed: %v", err)
+	}
+
+	return mapWebhookDeliveryToProto(*replay), nil
+}
+
+func parseDeliveryRequest(req *taskmanagerpb.WebhookDeliveryRequest) (uuid.UUID, uuid.UUID, error) {
+	webhookID, err := uuid.Parse(req.GetWebhookId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid webhook_id: %v", err)
+	}
+	deliveryID, err := uuid.Parse(req.GetDeliveryId())
+	if err != nil {
+		return uuid.Nil, uuid.Nil, status.Errorf(codes.InvalidArgument, "invalid delivery_id: %v", err)
+	}
+	return webhookID, deliveryID, nil
+}
+
+func eventTypes(names []string) []domain.TaskEventType {
+	events := make([]domain.TaskEventType, 0, len(names))
+	for _, name := range names {
+		events = append(events, domain.TaskEventType(name))
+	}
+	return events
+}
+
+func mapWebhookToProto(w *domain.Webhook) *taskmanagerpb.Webhook {
+	pb := &taskmanagerpb.Webhook{
+		Id:        w.ID.String(),
+		ProjectId: w.ProjectID.String(),
+		Url:       w.URL,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: w.UpdatedAt.Format(time.RFC3339),
+	}
+	for _, e := range w.Events {
+		pb.Events = append(pb.Events, string(e))
+	}
+	return pb
+}
+
+func mapWebhookDeliveryToProto(d domain.WebhookDelivery) *taskmanagerpb.WebhookDelivery {
+	pb := &taskmanagerpb.WebhookDelivery{
+		Id:        d.ID.String(),
+		WebhookId: d.WebhookID.String(),
+		EventId:   d.EventID.String(),
+		EventType: string(d.EventType),
+		Status:    string(d.Status),
+		Attempts:  int32(d.Attempts),
+		LastError: d.LastError,
+		CreatedAt: d.CreatedAt.Format(time.RFC3339),
+		UpdatedAt: d.UpdatedAt.Format(time.RFC3339),
+	}
+	if d.Status == domain.DeliveryPending {
+		pb.NextAttemptAt = d.NextAttemptAt.Format(time.RFC3339)
+	}
+	if d.ReplayOf != nil {
+		pb.ReplayOf = d.ReplayOf.String()
+	}
+	return pb
+}
@@ -0,0 +1,49 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultJWKSRefreshInterval = 15 * time.Minute
+
+type KeyRefresher interface {
+	Refresh(ctx context.Context) error
+}
+
+// JWKSRefresh keeps the token signing keys current, so that keys Keycloak
+// rotates in are known before the first token signed with them arrives and
+// keys it rotates out stop being accepted.
+type JWKSRefresh struct {
+	keys     KeyRefresher
+	interval time.Duration
+}
+
+// NewJWKSRefresh reads JWKS_REFRESH_INTERVAL (default 15m) as a Go duration.
+func NewJWKSRefresh(keys KeyRefresher) (*JWKSRefresh, error) {
+	interval, err := durationFromEnv("JWKS_REFRESH_INTERVAL", defaultJWKSRefreshInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &JWKSRefresh{keys: keys, interval: interval}, nil
+}
+
+// Run refreshes the keys once immediately and then on every interval until
+// ctx is done. A failed refresh keeps the keys fetched before.
+func (j *JWKSRefresh) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.keys.Refresh(ctx); err != nil {
+			log.Printf("JWKS refresh failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const (
+	defaultNotificationPollInterval = 10 * time.Second
+	defaultReminderInterval         = 15 * time.Minute
+	defaultReminderWindow           = 24 * time.Hour
+)
+
+type NotificationDeliverer interface {
+	DeliverDue(ctx context.Context, now time.Time) (int, error)
+	// Wake signals when new deliveries have been queued.
+	Wake() <-chan struct{}
+}
+
+// NotificationDispatcher delivers queued notifications in the background,
+// retrying failed deliveries once their backoff has passed.
+type NotificationDispatcher struct {
+	deliverer NotificationDeliverer
+	interval  time.Duration
+}
+
+// NewNotificationDispatcher reads NOTIFICATION_POLL_INTERVAL (default 10s) as
+// a Go duration.
+func NewNotificationDispatcher(deliverer NotificationDeliverer) (*NotificationDispatcher, error) {
+	interval, err := durationFromEnv("NOTIFICATION_POLL_INTERVAL", defaultNotificationPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &NotificationDispatcher{deliverer: deliverer, interval: interval}, nil
+}
+
+// Run delivers due notifications once immediately, then on every interval and
+// whenever new ones are queued, until ctx is done.
+func (j *NotificationDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.deliverer.DeliverDue(ctx, time.Now()); err != nil {
+			log.Printf("notification delivery failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-j.deliverer.Wake():
+		}
+	}
+}
+
+type DueSoonReminder interface {
+	RemindDueSoon(ctx context.Context, now time.Time, window time.Duration) (int, error)
+}
+
+// DueReminder periodically reminds assignees of tasks that are due soon.
+type DueReminder struct {
+	reminder DueSoonReminder
+	interval time.Duration
+	window   time.Duration
+}
+
+// NewDueReminder reads REMINDER_INTERVAL (default 15m) and REMINDER_WINDOW,
+// how long before the due date to remind (default 24h), as Go durations.
+func NewDueReminder(reminder DueSoonReminder) (*DueReminder, error) {
+	interval, err := durationFromEnv("REMINDER_INTERVAL", defaultReminderInterval)
+	if err != nil {
+		return nil, err
+	}
+	window, err := durationFromEnv("REMINDER_WINDOW", defaultReminderWindow)
+	if err != nil {
+		return nil, err
+	}
+	return &DueReminder{reminder: reminder, interval: interval, window: window}, nil
+}
+
+// Run sends reminders once immediately and then on every interval until ctx
+// is done.
+func (j *DueReminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		reminded, err := j.reminder.RemindDueSoon(ctx, time.Now(), j.window)
+		if err != nil {
+			log.Printf("due-soon reminders failed: %v", err)
+		}
+		if reminded > 0 {
+			log.Printf("sent %d due-soon reminder(s)", reminded)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
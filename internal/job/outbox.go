@@ -0,0 +1,48 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultOutboxPollInterval = time.Second
+
+type OutboxRelayer interface {
+	RelayDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// OutboxRelay hands the task events written to the outbox to their
+// subscribers in the background.
+type OutboxRelay struct {
+	relayer  OutboxRelayer
+	interval time.Duration
+}
+
+// NewOutboxRelay reads OUTBOX_POLL_INTERVAL (default 1s) as a Go duration.
+func NewOutboxRelay(relayer OutboxRelayer) (*OutboxRelay, error) {
+	interval, err := durationFromEnv("OUTBOX_POLL_INTERVAL", defaultOutboxPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &OutboxRelay{relayer: relayer, interval: interval}, nil
+}
+
+// Run relays due events once immediately and then on every interval until
+// ctx is done.
+func (j *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.relayer.RelayDue(ctx, time.Now()); err != nil {
+			log.Printf("outbox relay failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultRecurrenceInterval = time.Minute
+
+type OccurrenceMaterializer interface {
+	MaterializeDue(ctx context.Context, now time.Time) (int, error)
+}
+
+// RecurrenceScheduler periodically creates the next occurrences of recurring
+// tasks whose start time has arrived or whose current occurrence is done.
+type RecurrenceScheduler struct {
+	materializer OccurrenceMaterializer
+	interval     time.Duration
+}
+
+// NewRecurrenceScheduler reads RECURRENCE_INTERVAL (default 1m) as a Go duration.
+func NewRecurrenceScheduler(materializer OccurrenceMaterializer) (*RecurrenceScheduler, error) {
+	interval, err := durationFromEnv("RECURRENCE_INTERVAL", defaultRecurrenceInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &RecurrenceScheduler{materializer: materializer, interval: interval}, nil
+}
+
+// Run materializes due occurrences once immediately and then on every
+// interval until ctx is done.
+func (j *RecurrenceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		created, err := j.materializer.MaterializeDue(ctx, time.Now())
+		if err != nil {
+			log.Printf("recurring task scheduling failed: %v", err)
+		}
+		if created > 0 {
+			log.Printf("created %d recurring task occurrence(s)", created)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
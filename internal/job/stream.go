@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultStreamPollInterval = 500 * time.Millisecond
+
+type StreamFollower interface {
+	Follow(ctx context.Context) (int, error)
+	// Wake signals when this instance has logged an event.
+	Wake() <-chan struct{}
+}
+
+// StreamFanout passes the task events logged by every instance on to the
+// live streams of this one.
+type StreamFanout struct {
+	follower StreamFollower
+	interval time.Duration
+}
+
+// NewStreamFanout reads STREAM_POLL_INTERVAL (default 500ms) as a Go
+// duration. It bounds how late events relayed by other instances arrive.
+func NewStreamFanout(follower StreamFollower) (*StreamFanout, error) {
+	interval, err := durationFromEnv("STREAM_POLL_INTERVAL", defaultStreamPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &StreamFanout{follower: follower, interval: interval}, nil
+}
+
+// Run follows the log once immediately, then on every interval and whenever
+// this instance logs an event, until ctx is done.
+func (j *StreamFanout) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.follower.Follow(ctx); err != nil {
+			log.Printf("stream fanout failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-j.follower.Wake():
+		}
+	}
+}
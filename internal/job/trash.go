@@ -0,0 +1,101 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"time"
+)
+
+const (
+	defaultTrashRetention     = 30 * 24 * time.Hour
+	defaultTrashPurgeInterval = time.Hour
+)
+
+type TrashPurger interface {
+	PurgeTrash(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// AttachmentPurger removes the files of trashed tasks and comments, which the
+// database alone cannot clean up from the blob store.
+type AttachmentPurger interface {
+	PurgeTrashed(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// TrashPurge periodically removes items that have been in the trash for
+// longer than the retention period.
+type TrashPurge struct {
+	purger      TrashPurger
+	attachments AttachmentPurger
+	retention   time.Duration
+	interval    time.Duration
+}
+
+// NewTrashPurge reads TRASH_RETENTION (default 720h) and TRASH_PURGE_INTERVAL
+// (default 1h) as Go durations.
+func NewTrashPurge(purger TrashPurger, attachments AttachmentPurger) (*TrashPurge, error) {
+	retention, err := durationFromEnv("TRASH_RETENTION", defaultTrashRetention)
+	if err != nil {
+		return nil, err
+	}
+	interval, err := durationFromEnv("TRASH_PURGE_INTERVAL", defaultTrashPurgeInterval)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TrashPurge{
+		purger:      purger,
+		attachments: attachments,
+		retention:   retention,
+		interval:    interval,
+	}, nil
+}
+
+// Run purges once immediately and then on every interval until ctx is done.
+func (j *TrashPurge) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// purge removes attachments before the tasks and comments they belong to, so
+// that their storage keys are still known when the blobs are deleted.
+func (j *TrashPurge) purge(ctx context.Context) {
+	files, err := j.attachments.PurgeTrashed(ctx, j.retention)
+	if err != nil {
+		log.Printf("attachment purge failed: %v", err)
+		return
+	}
+	if files > 0 {
+		log.Printf("purged %d attachment(s) from the trash", files)
+	}
+
+	purged, err := j.purger.PurgeTrash(ctx, j.retention)
+	if err != nil {
+		log.Printf("trash purge failed: %v", err)
+	} else if purged > 0 {
+		log.Printf("purged %d task(s) from the trash", purged)
+	}
+}
+
+func durationFromEnv(key string, fallback time.Duration) (time.Duration, error) {
+	raw := os.Getenv(key)
+	if raw == "" {
+		return fallback, nil
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid %s: %q", key, raw)
+	}
+	return d, nil
+}
@@ -0,0 +1,52 @@
+package job
+
+import (
+	"context"
+	"log"
+	"time"
+)
+
+const defaultWebhookPollInterval = 10 * time.Second
+
+type WebhookDeliverer interface {
+	DeliverDue(ctx context.Context, now time.Time) (int, error)
+	// Wake signals when new deliveries have been queued.
+	Wake() <-chan struct{}
+}
+
+// WebhookDispatcher sends queued webhook deliveries in the background, so
+// that task changes never wait on subscribers.
+type WebhookDispatcher struct {
+	deliverer WebhookDeliverer
+	interval  time.Duration
+}
+
+// NewWebhookDispatcher reads WEBHOOK_POLL_INTERVAL (default 10s) as a Go
+// duration.
+func NewWebhookDispatcher(deliverer WebhookDeliverer) (*WebhookDispatcher, error) {
+	interval, err := durationFromEnv("WEBHOOK_POLL_INTERVAL", defaultWebhookPollInterval)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookDispatcher{deliverer: deliverer, interval: interval}, nil
+}
+
+// Run sends due deliveries once immediately, then on every interval and
+// whenever new ones are queued, until ctx is done.
+func (j *WebhookDispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := j.deliverer.DeliverDue(ctx, time.Now()); err != nil {
+			log.Printf("webhook delivery failed: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-j.deliverer.Wake():
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ActivityRepository struct {
+	db *gorm.DB
+}
+
+func NewActivityRepository(db *gorm.DB) *ActivityRepository {
+	return &ActivityRepository{db: db}
+}
+
+func (r *ActivityRepository) Create(ctx context.Context, activity *domain.Activity) error {
+	m := model.NewTaskActivityModel(*activity)
+	return conn(ctx, r.db).Create(&m).Error
+}
+
+// ListByTask returns one page of a task's activity, newest first, and the
+// cursor of the next page (empty on the last page).
+func (r *ActivityRepository) ListByTask(
+	ctx context.Context,
+	taskID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Activity, string, error) {
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := conn(ctx, r.db).Where("task_id = ?", taskID)
+	if after != nil {
+		if after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, *after.Key)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, after.ID)
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var models []model.TaskActivity
+	if err := query.
+		Order("created_at desc, id desc").
+		Limit(limit + 1).
+		Find(&models).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(models) > limit {
+		models = models[:limit]
+		last := models[limit-1]
+		key := last.CreatedAt.Format(time.RFC3339Nano)
+		next = pagination.Cursor{Key: &key, ID: last.ID}.Encode()
+	}
+
+	activities := make([]domain.Activity, 0, len(models))
+	for _, m := range models {
+		activities = append(activities, m.ToDomain())
+	}
+	return activities, next, nil
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AttachmentRepository struct {
+	db *gorm.DB
+}
+
+func NewAttachmentRepository(db *gorm.DB) *AttachmentRepository {
+	return &AttachmentRepository{db: db}
+}
+
+func (r *AttachmentRepository) Create(ctx context.Context, attachment *domain.Attachment) error {
+	m := model.NewAttachmentModel(*attachment)
+	return conn(ctx, r.db).Create(&m).Error
+}
+
+func (r *AttachmentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Attachment, error) {
+	var m model.Attachment
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	a := m.ToDomain()
+	return &a, nil
+}
+
+// ListByTask returns the attachments of a task and of its comments, oldest first.
+func (r *AttachmentRepository) ListByTask(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error) {
+	var models []model.Attachment
+	if err := conn(ctx, r.db).
+		Where("task_id = ?", taskID).
+		Order("created_at, id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	attachments := make([]domain.Attachment, 0, len(models))
+	for _, m := range models {
+		attachments = append(attachments, m.ToDomain())
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) ListTrashed(ctx context.Context, before time.Time) ([]domain.Attachment, error) {
+	var models []model.Attachment
+	if err := conn(ctx, r.db).
+		Where(`EXISTS (SELECT 1 FROM tasks t WHERE t.id = attachments.task_id AND t.deleted_at < ?)
+			OR EXISTS (SELECT 1 FROM comments c WHERE c.id = attachments.comment_id AND c.deleted_at < ?)`,
+			before, before).
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	attachments := make([]domain.Attachment, 0, len(models))
+	for _, m := range models {
+		attachments = append(attachments, m.ToDomain())
+	}
+	return attachments, nil
+}
+
+func (r *AttachmentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	res := conn(ctx, r.db).Delete(&model.Attachment{}, "id = ?", id)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
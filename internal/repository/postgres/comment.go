@@ -2,9 +2,12 @@ package postgres
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"task-manager/domain"
 	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -18,13 +21,103 @@ func NewCommentRepository(db *gorm.DB) *CommentRepository {
 	return &CommentRepository{db: db}
 }
 
-func (r *CommentRepository) Create(ctx context.Context, taskID, userID uuid.UUID, content string) error {
-	comment := model.Comment{
-		ID:        uuid.New(),
-		TaskID:    taskID,
-		UserID:    userID,
-		Content:   content,
-		CreatedAt: time.Now(),
+func (r *CommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	m := model.NewCommentModel(*comment)
+	return conn(ctx, r.db).Create(&m).Error
+}
+
+func (r *CommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	var m model.Comment
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	c := m.ToDomain()
+	return &c, nil
+}
+
+// ListByTask returns one page of a task's comments, oldest first, and the
+// cursor of the next page (empty on the last page).
+func (r *CommentRepository) ListByTask(
+	ctx context.Context,
+	taskID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Comment, string, error) {
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := conn(ctx, r.db).Where("task_id = ?", taskID)
+	if after != nil {
+		if after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, *after.Key)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		query = query.Where("(created_at, id) > (?, ?)", createdAt, after.ID)
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var models []model.Comment
+	if err := query.
+		Order("created_at asc, id asc").
+		Limit(limit + 1).
+		Find(&models).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(models) > limit {
+		models = models[:limit]
+		last := models[limit-1]
+		key := last.CreatedAt.Format(time.RFC3339Nano)
+		next = pagination.Cursor{Key: &key, ID: last.ID}.Encode()
+	}
+
+	comments := make([]domain.Comment, 0, len(models))
+	for _, m := range models {
+		comments = append(comments, m.ToDomain())
+	}
+	return comments, next, nil
+}
+
+// Update saves the content and edit time of a comment.
+func (r *CommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	res := conn(ctx, r.db).
+		Model(&model.Comment{}).
+		Where("id = ?", comment.ID).
+		Updates(map[string]interface{}{
+			"content":   comment.Content,
+			"edited_at": comment.EditedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// Delete moves a comment to the trash. Its replies stay visible.
+func (r *CommentRepository) Delete(ctx context.Context, id, deletedBy uuid.UUID, at time.Time) error {
+	res := conn(ctx, r.db).
+		Model(&model.Comment{}).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": at,
+			"deleted_by": deletedBy,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
 	}
-	return r.db.WithContext(ctx).Create(&comment).Error
+	return nil
 }
@@ -9,7 +9,7 @@ import (
 
 func NewDB() (*gorm.DB, error) {
 	dsn := os.Getenv("DATABASE_DSN")
-	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{})
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{TranslateError: true})
 	if err != nil {
 		return nil, err
 	}
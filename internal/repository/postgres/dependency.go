@@ -0,0 +1,81 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DependencyRepository struct {
+	db *gorm.DB
+}
+
+func NewDependencyRepository(db *gorm.DB) *DependencyRepository {
+	return &DependencyRepository{db: db}
+}
+
+// Add records that blockerID blocks blockedID. Adding an existing dependency is a no-op.
+func (r *DependencyRepository) Add(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	dep := model.TaskDependency{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+		CreatedAt: time.Now(),
+	}
+	return conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&dep).Error
+}
+
+func (r *DependencyRepository) Remove(ctx context.Context, blockerID, blockedID uuid.UUID) error {
+	return conn(ctx, r.db).
+		Delete(&model.TaskDependency{}, "blocker_id = ? AND blocked_id = ?", blockerID, blockedID).Error
+}
+
+// ListBlockerIDs returns the IDs of the tasks that directly block taskID.
+func (r *DependencyRepository) ListBlockerIDs(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	if err := conn(ctx, r.db).
+		Model(&model.TaskDependency{}).
+		Where("blocked_id = ?", taskID).
+		Pluck("blocker_id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListBlockers returns the tasks that directly block taskID.
+func (r *DependencyRepository) ListBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error) {
+	return r.listJoined(ctx, "task_dependencies.blocker_id", "task_dependencies.blocked_id", taskID)
+}
+
+// ListBlocked returns the tasks directly blocked by taskID.
+func (r *DependencyRepository) ListBlocked(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error) {
+	return r.listJoined(ctx, "task_dependencies.blocked_id", "task_dependencies.blocker_id", taskID)
+}
+
+func (r *DependencyRepository) listJoined(
+	ctx context.Context,
+	joinColumn, filterColumn string,
+	taskID uuid.UUID,
+) ([]domain.Task, error) {
+	var models []model.Task
+	if err := conn(ctx, r.db).
+		Joins("JOIN task_dependencies ON tasks.id = "+joinColumn).
+		Where(filterColumn+" = ?", taskID).
+		Order("tasks.created_at asc").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]domain.Task, 0, len(models))
+	for _, m := range models {
+		tasks = append(tasks, m.ToDomain())
+	}
+	return tasks, nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type LabelRepository struct {
+	db *gorm.DB
+}
+
+func NewLabelRepository(db *gorm.DB) *LabelRepository {
+	return &LabelRepository{db: db}
+}
+
+func (r *LabelRepository) Create(ctx context.Context, label *domain.Label) error {
+	m := model.NewLabelModel(*label)
+	if err := conn(ctx, r.db).Create(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return domain.ErrConflict
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	var m model.Label
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	l := m.ToDomain()
+	return &l, nil
+}
+
+func (r *LabelRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Label, error) {
+	var models []model.Label
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("name").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	labels := make([]domain.Label, 0, len(models))
+	for _, m := range models {
+		labels = append(labels, m.ToDomain())
+	}
+	return labels, nil
+}
+
+func (r *LabelRepository) Update(ctx context.Context, label *domain.Label) error {
+	m := model.NewLabelModel(*label)
+	if err := conn(ctx, r.db).Save(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return domain.ErrConflict
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *LabelRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&model.Label{}, "id = ?", id).Error
+}
+
+// Attach adds a label to a task. Attaching it twice is a no-op.
+func (r *LabelRepository) Attach(ctx context.Context, taskID, labelID uuid.UUID) error {
+	return conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.TaskLabel{TaskID: taskID, LabelID: labelID}).Error
+}
+
+func (r *LabelRepository) Detach(ctx context.Context, taskID, labelID uuid.UUID) error {
+	return conn(ctx, r.db).
+		Delete(&model.TaskLabel{}, "task_id = ? AND label_id = ?", taskID, labelID).Error
+}
@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type MentionRepository struct {
+	db *gorm.DB
+}
+
+func NewMentionRepository(db *gorm.DB) *MentionRepository {
+	return &MentionRepository{db: db}
+}
+
+func (r *MentionRepository) Create(ctx context.Context, mentions []domain.Mention) error {
+	models := make([]model.Mention, 0, len(mentions))
+	for _, m := range mentions {
+		models = append(models, model.NewMentionModel(m))
+	}
+	return conn(ctx, r.db).Create(&models).Error
+}
+
+// ListByUser returns one page of the places a user was mentioned, newest
+// first, and the cursor of the next page (empty on the last page). Mentions
+// in trashed tasks or comments are left out.
+func (r *MentionRepository) ListByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Mention, string, error) {
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := conn(ctx, r.db).
+		Where("user_id = ?", userID).
+		Where("NOT EXISTS (SELECT 1 FROM tasks t WHERE t.id = mentions.task_id AND t.deleted_at IS NOT NULL)").
+		Where(`comment_id IS NULL OR NOT EXISTS (
+			SELECT 1 FROM comments c WHERE c.id = mentions.comment_id AND c.deleted_at IS NOT NULL
+		)`)
+	if after != nil {
+		if after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, *after.Key)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, after.ID)
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var models []model.Mention
+	if err := query.
+		Order("created_at desc, id desc").
+		Limit(limit + 1).
+		Find(&models).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(models) > limit {
+		models = models[:limit]
+		last := models[limit-1]
+		key := last.CreatedAt.Format(time.RFC3339Nano)
+		next = pagination.Cursor{Key: &key, ID: last.ID}.Encode()
+	}
+
+	mentions := make([]domain.Mention, 0, len(models))
+	for _, m := range models {
+		mentions = append(mentions, m.ToDomain())
+	}
+	return mentions, next, nil
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type FieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+type TaskActivity struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	TaskID    uuid.UUID
+	ActorID   uuid.UUID
+	Action    string
+	Changes   []FieldChange `gorm:"serializer:json"`
+	CreatedAt time.Time
+}
+
+func NewTaskActivityModel(a domain.Activity) TaskActivity {
+	changes := make([]FieldChange, 0, len(a.Changes))
+	for _, c := range a.Changes {
+		changes = append(changes, FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+
+	return TaskActivity{
+		ID:        a.ID,
+		TaskID:    a.TaskID,
+		ActorID:   a.ActorID,
+		Action:    string(a.Action),
+		Changes:   changes,
+		CreatedAt: a.CreatedAt,
+	}
+}
+
+func (m TaskActivity) ToDomain() domain.Activity {
+	changes := make([]domain.FieldChange, 0, len(m.Changes))
+	for _, c := range m.Changes {
+		changes = append(changes, domain.FieldChange{Field: c.Field, From: c.From, To: c.To})
+	}
+
+	return domain.Activity{
+		ID:        m.ID,
+		TaskID:    m.TaskID,
+		ActorID:   m.ActorID,
+		Action:    domain.ActivityAction(m.Action),
+		Changes:   changes,
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Attachment struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	TaskID      uuid.UUID
+	CommentID   *uuid.UUID
+	UploaderID  uuid.UUID
+	FileName    string
+	ContentType string
+	Size        int64
+	SHA256      string `gorm:"column:sha256"`
+	StorageKey  string
+	CreatedAt   time.Time
+}
+
+func NewAttachmentModel(a domain.Attachment) Attachment {
+	return Attachment{
+		ID:          a.ID,
+		TaskID:      a.TaskID,
+		CommentID:   a.CommentID,
+		UploaderID:  a.UploaderID,
+		FileName:    a.FileName,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		SHA256:      a.SHA256,
+		StorageKey:  a.StorageKey,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func (m Attachment) ToDomain() domain.Attachment {
+	return domain.Attachment{
+		ID:          m.ID,
+		TaskID:      m.TaskID,
+		CommentID:   m.CommentID,
+		UploaderID:  m.UploaderID,
+		FileName:    m.FileName,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		SHA256:      m.SHA256,
+		StorageKey:  m.StorageKey,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -3,13 +3,44 @@ package model
 import (
 	"time"
 
+	"task-manager/domain"
+
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Comment struct {
 	ID        uuid.UUID `gorm:"primaryKey"`
 	TaskID    uuid.UUID
+	ParentID  *uuid.UUID
 	UserID    uuid.UUID
 	Content   string
 	CreatedAt time.Time
+	EditedAt  *time.Time
+	DeletedAt gorm.DeletedAt
+	DeletedBy *uuid.UUID
+}
+
+func NewCommentModel(c domain.Comment) Comment {
+	return Comment{
+		ID:        c.ID,
+		TaskID:    c.TaskID,
+		ParentID:  c.ParentID,
+		UserID:    c.UserID,
+		Content:   c.Content,
+		CreatedAt: c.CreatedAt,
+		EditedAt:  c.EditedAt,
+	}
+}
+
+func (m Comment) ToDomain() domain.Comment {
+	return domain.Comment{
+		ID:        m.ID,
+		TaskID:    m.TaskID,
+		ParentID:  m.ParentID,
+		UserID:    m.UserID,
+		Content:   m.Content,
+		CreatedAt: m.CreatedAt,
+		EditedAt:  m.EditedAt,
+	}
 }
@@ -0,0 +1,13 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TaskDependency struct {
+	BlockerID uuid.UUID `gorm:"primaryKey"`
+	BlockedID uuid.UUID `gorm:"primaryKey"`
+	CreatedAt time.Time
+}
@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Label struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	ProjectID uuid.UUID
+	Name      string
+	Color     string
+	CreatedAt time.Time
+}
+
+type TaskLabel struct {
+	TaskID  uuid.UUID `gorm:"primaryKey"`
+	LabelID uuid.UUID `gorm:"primaryKey"`
+}
+
+func NewLabelModel(l domain.Label) Label {
+	return Label{
+		ID:        l.ID,
+		ProjectID: l.ProjectID,
+		Name:      l.Name,
+		Color:     l.Color,
+		CreatedAt: l.CreatedAt,
+	}
+}
+
+func (m Label) ToDomain() domain.Label {
+	return domain.Label{
+		ID:        m.ID,
+		ProjectID: m.ProjectID,
+		Name:      m.Name,
+		Color:     m.Color,
+		CreatedAt: m.CreatedAt,
+	}
+}
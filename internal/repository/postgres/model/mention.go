@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Mention struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	UserID    uuid.UUID
+	TaskID    uuid.UUID
+	CommentID *uuid.UUID
+	AuthorID  uuid.UUID
+	CreatedAt time.Time
+}
+
+func NewMentionModel(m domain.Mention) Mention {
+	return Mention{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		TaskID:    m.TaskID,
+		CommentID: m.CommentID,
+		AuthorID:  m.AuthorID,
+		CreatedAt: m.CreatedAt,
+	}
+}
+
+func (m Mention) ToDomain() domain.Mention {
+	return domain.Mention{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		TaskID:    m.TaskID,
+		CommentID: m.CommentID,
+		AuthorID:  m.AuthorID,
+		CreatedAt: m.CreatedAt,
+	}
+}
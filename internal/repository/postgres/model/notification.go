@@ -0,0 +1,183 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Notification struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	UserID    uuid.UUID
+	Event     string
+	TaskID    uuid.UUID
+	CommentID *uuid.UUID
+	ActorID   *uuid.UUID
+	Subject   string
+	Body      string
+	CreatedAt time.Time
+}
+
+func NewNotificationModel(n domain.Notification) Notification {
+	m := Notification{
+		ID:        n.ID,
+		UserID:    n.UserID,
+		Event:     string(n.Event),
+		TaskID:    n.TaskID,
+		CommentID: n.CommentID,
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.ActorID != uuid.Nil {
+		m.ActorID = &n.ActorID
+	}
+	return m
+}
+
+func (m Notification) ToDomain() domain.Notification {
+	n := domain.Notification{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Event:     domain.NotificationEvent(m.Event),
+		TaskID:    m.TaskID,
+		CommentID: m.CommentID,
+		Subject:   m.Subject,
+		Body:      m.Body,
+		CreatedAt: m.CreatedAt,
+	}
+	if m.ActorID != nil {
+		n.ActorID = *m.ActorID
+	}
+	return n
+}
+
+type NotificationDelivery struct {
+	ID             uuid.UUID `gorm:"primaryKey"`
+	NotificationID uuid.UUID
+	Channel        string
+	Status         string
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastError      string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func NewNotificationDeliveryModel(d domain.Delivery) NotificationDelivery {
+	return NotificationDelivery{
+		ID:             d.ID,
+		NotificationID: d.NotificationID,
+		Channel:        string(d.Channel),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastError:      d.LastError,
+		CreatedAt:      d.CreatedAt,
+		UpdatedAt:      d.UpdatedAt,
+	}
+}
+
+func (m NotificationDelivery) ToDomain() domain.Delivery {
+	return domain.Delivery{
+		ID:             m.ID,
+		NotificationID: m.NotificationID,
+		Channel:        domain.NotificationChannel(m.Channel),
+		Status:         domain.DeliveryStatus(m.Status),
+		Attempts:       m.Attempts,
+		NextAttemptAt:  m.NextAttemptAt,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt,
+		UpdatedAt:      m.UpdatedAt,
+	}
+}
+
+type NotificationAttempt struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	DeliveryID  uuid.UUID
+	Attempt     int
+	Succeeded   bool
+	Error       string
+	AttemptedAt time.Time
+}
+
+func NewNotificationAttemptModel(a domain.DeliveryAttempt) NotificationAttempt {
+	return NotificationAttempt{
+		ID:          a.ID,
+		DeliveryID:  a.DeliveryID,
+		Attempt:     a.Attempt,
+		Succeeded:   a.Succeeded,
+		Error:       a.Error,
+		AttemptedAt: a.AttemptedAt,
+	}
+}
+
+type InboxItem struct {
+	NotificationID uuid.UUID `gorm:"primaryKey"`
+	UserID         uuid.UUID
+	ReadAt         *time.Time
+	CreatedAt      time.Time
+}
+
+type NotificationPreferences struct {
+	UserID          uuid.UUID           `gorm:"primaryKey"`
+	Channels        map[string][]string `gorm:"serializer:json"`
+	Email           string
+	WebhookURL      string
+	QuietHoursStart string
+	QuietHoursEnd   string
+	Timezone        string
+	UpdatedAt       time.Time
+}
+
+func NewNotificationPreferencesModel(p domain.NotificationPreferences) NotificationPreferences {
+	channels := make(map[string][]string, len(p.Channels))
+	for event, chs := range p.Channels {
+		names := make([]string, 0, len(chs))
+		for _, ch := range chs {
+			names = append(names, string(ch))
+		}
+		channels[string(event)] = names
+	}
+
+	return NotificationPreferences{
+		UserID:          p.UserID,
+		Channels:        channels,
+		Email:           p.Email,
+		WebhookURL:      p.WebhookURL,
+		QuietHoursStart: p.QuietHoursStart,
+		QuietHoursEnd:   p.QuietHoursEnd,
+		Timezone:        p.Timezone,
+		UpdatedAt:       p.UpdatedAt,
+	}
+}
+
+func (m NotificationPreferences) ToDomain() domain.NotificationPreferences {
+	channels := make(map[domain.NotificationEvent][]domain.NotificationChannel, len(m.Channels))
+	for event, names := range m.Channels {
+		chs := make([]domain.NotificationChannel, 0, len(names))
+		for _, name := range names {
+			chs = append(chs, domain.NotificationChannel(name))
+		}
+		channels[domain.NotificationEvent(event)] = chs
+	}
+
+	return domain.NotificationPreferences{
+		UserID:          m.UserID,
+		Channels:        channels,
+		Email:           m.Email,
+		WebhookURL:      m.WebhookURL,
+		QuietHoursStart: m.QuietHoursStart,
+		QuietHoursEnd:   m.QuietHoursEnd,
+		Timezone:        m.Timezone,
+		UpdatedAt:       m.UpdatedAt,
+	}
+}
+
+type TaskReminder struct {
+	TaskID  uuid.UUID `gorm:"primaryKey"`
+	DueDate time.Time `gorm:"primaryKey"`
+	SentAt  time.Time
+}
@@ -0,0 +1,46 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type OutboxEvent struct {
+	ID uuid.UUID `gorm:"primaryKey"`
+	// Sequence is assigned by the database.
+	Sequence    int64 `gorm:"->"`
+	TaskID      uuid.UUID
+	EventType   string
+	Payload     domain.TaskEvent `gorm:"serializer:json"`
+	Attempts    int
+	AvailableAt time.Time
+	LastError   string
+	CreatedAt   time.Time
+}
+
+func NewOutboxEventModel(e domain.TaskEvent, at time.Time) OutboxEvent {
+	return OutboxEvent{
+		ID:          e.ID,
+		TaskID:      e.Task.ID,
+		EventType:   string(e.Type),
+		Payload:     e,
+		AvailableAt: at,
+		CreatedAt:   at,
+	}
+}
+
+func (m OutboxEvent) ToDomain() domain.OutboxEvent {
+	event := m.Payload
+	event.Sequence = m.Sequence
+	return domain.OutboxEvent{
+		Sequence:    m.Sequence,
+		Event:       event,
+		Attempts:    m.Attempts,
+		AvailableAt: m.AvailableAt,
+		LastError:   m.LastError,
+		CreatedAt:   m.CreatedAt,
+	}
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Project struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	Name        string
+	Key         string
+	Description string
+	OwnerID     uuid.UUID
+	Archived    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type ProjectMember struct {
+	ProjectID uuid.UUID `gorm:"primaryKey"`
+	UserID    uuid.UUID `gorm:"primaryKey"`
+	Role      string
+	CreatedAt time.Time
+}
+
+func NewProjectModel(p domain.Project) Project {
+	return Project{
+		ID:          p.ID,
+		Name:        p.Name,
+		Key:         p.Key,
+		Description: p.Description,
+		OwnerID:     p.OwnerID,
+		Archived:    p.Archived,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func (m Project) ToDomain() domain.Project {
+	return domain.Project{
+		ID:          m.ID,
+		Name:        m.Name,
+		Key:         m.Key,
+		Description: m.Description,
+		OwnerID:     m.OwnerID,
+		Archived:    m.Archived,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+func NewProjectMemberModel(pm domain.ProjectMember) ProjectMember {
+	return ProjectMember{
+		ProjectID: pm.ProjectID,
+		UserID:    pm.UserID,
+		Role:      string(pm.Role),
+		CreatedAt: pm.CreatedAt,
+	}
+}
+
+func (m ProjectMember) ToDomain() domain.ProjectMember {
+	return domain.ProjectMember{
+		ProjectID: m.ProjectID,
+		UserID:    m.UserID,
+		Role:      domain.ProjectRole(m.Role),
+		CreatedAt: m.CreatedAt,
+	}
+}
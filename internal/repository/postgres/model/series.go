@@ -0,0 +1,85 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Series struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	ProjectID     uuid.UUID
+	RRule         string `gorm:"column:rrule"`
+	Timezone      string
+	Start         time.Time
+	Title         string
+	Description   string
+	Priority      string
+	AssignedTo    uuid.UUID
+	DueAfter      *int64
+	CurrentTaskID uuid.UUID
+	LastAt        time.Time
+	NextAt        *time.Time
+	StoppedAt     *time.Time
+	CreatedBy     uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func (Series) TableName() string {
+	return "task_series"
+}
+
+func NewSeriesModel(s domain.Series) Series {
+	m := Series{
+		ID:            s.ID,
+		ProjectID:     s.ProjectID,
+		RRule:         s.RRule,
+		Timezone:      s.Timezone,
+		Start:         s.Start,
+		Title:         s.Title,
+		Description:   s.Description,
+		Priority:      string(s.Priority),
+		AssignedTo:    s.AssignedTo,
+		CurrentTaskID: s.CurrentTaskID,
+		LastAt:        s.LastAt,
+		NextAt:        s.NextAt,
+		StoppedAt:     s.StoppedAt,
+		CreatedBy:     s.CreatedBy,
+		CreatedAt:     s.CreatedAt,
+		UpdatedAt:     s.UpdatedAt,
+	}
+	if s.DueAfter != nil {
+		seconds := int64(*s.DueAfter / time.Second)
+		m.DueAfter = &seconds
+	}
+	return m
+}
+
+func (m Series) ToDomain() domain.Series {
+	s := domain.Series{
+		ID:            m.ID,
+		ProjectID:     m.ProjectID,
+		RRule:         m.RRule,
+		Timezone:      m.Timezone,
+		Start:         m.Start,
+		Title:         m.Title,
+		Description:   m.Description,
+		Priority:      domain.Priority(m.Priority),
+		AssignedTo:    m.AssignedTo,
+		CurrentTaskID: m.CurrentTaskID,
+		LastAt:        m.LastAt,
+		NextAt:        m.NextAt,
+		StoppedAt:     m.StoppedAt,
+		CreatedBy:     m.CreatedBy,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+	if m.DueAfter != nil {
+		dueAfter := time.Duration(*m.DueAfter) * time.Second
+		s.DueAfter = &dueAfter
+	}
+	return s
+}
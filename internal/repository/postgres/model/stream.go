@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type StreamEvent struct {
+	// Position is assigned by the database.
+	Position  int64 `gorm:"primaryKey;->"`
+	ID        uuid.UUID
+	Payload   domain.TaskEvent `gorm:"serializer:json"`
+	CreatedAt time.Time
+}
+
+func NewStreamEventModel(e domain.TaskEvent, at time.Time) StreamEvent {
+	return StreamEvent{ID: e.ID, Payload: e, CreatedAt: at}
+}
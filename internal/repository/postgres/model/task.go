@@ -6,6 +6,7 @@ import (
 	"task-manager/domain"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type Task struct {
@@ -13,10 +14,19 @@ type Task struct {
 	Title       string
 	Description string
 	Status      string
+	Priority    string
 	AssignedTo  uuid.UUID
 	ProjectID   uuid.UUID
+	ParentID    *uuid.UUID
+	SeriesID    *uuid.UUID
+	StartDate   *time.Time
+	DueDate     *time.Time
+	CompletedAt *time.Time
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
+	Version     int64
+	DeletedAt   gorm.DeletedAt
+	DeletedBy   *uuid.UUID
 }
 
 func NewTaskModel(t domain.Task) Task {
@@ -25,10 +35,19 @@ func NewTaskModel(t domain.Task) Task {
 		Title:       t.Title,
 		Description: t.Description,
 		Status:      t.Status,
+		Priority:    string(t.Priority),
 		AssignedTo:  t.AssignedTo,
 		ProjectID:   t.ProjectID,
+		ParentID:    t.ParentID,
+		SeriesID:    t.SeriesID,
+		StartDate:   t.StartDate,
+		DueDate:     t.DueDate,
+		CompletedAt: t.CompletedAt,
 		CreatedAt:   t.CreatedAt,
 		UpdatedAt:   t.UpdatedAt,
+		Version:     t.Version,
+		DeletedAt:   deletedAt(t.DeletedAt),
+		DeletedBy:   t.DeletedBy,
 	}
 }
 
@@ -38,9 +57,32 @@ func (m Task) ToDomain() domain.Task {
 		Title:       m.Title,
 		Description: m.Description,
 		Status:      m.Status,
+		Priority:    domain.Priority(m.Priority),
 		AssignedTo:  m.AssignedTo,
 		ProjectID:   m.ProjectID,
+		ParentID:    m.ParentID,
+		SeriesID:    m.SeriesID,
+		StartDate:   m.StartDate,
+		DueDate:     m.DueDate,
+		CompletedAt: m.CompletedAt,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
+		Version:     m.Version,
+		DeletedAt:   deletedAtPtr(m.DeletedAt),
+		DeletedBy:   m.DeletedBy,
 	}
 }
+
+func deletedAt(t *time.Time) gorm.DeletedAt {
+	if t == nil {
+		return gorm.DeletedAt{}
+	}
+	return gorm.DeletedAt{Time: *t, Valid: true}
+}
+
+func deletedAtPtr(d gorm.DeletedAt) *time.Time {
+	if !d.Valid {
+		return nil
+	}
+	return &d.Time
+}
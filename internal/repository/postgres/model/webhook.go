@@ -0,0 +1,146 @@
+package model
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	ID        uuid.UUID `gorm:"primaryKey"`
+	ProjectID uuid.UUID
+	URL       string
+	Secret    string
+	Events    []string `gorm:"serializer:json"`
+	Active    bool
+	CreatedBy *uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func NewWebhookModel(w domain.Webhook) Webhook {
+	events := make([]string, 0, len(w.Events))
+	for _, e := range w.Events {
+		events = append(events, string(e))
+	}
+	m := Webhook{
+		ID:        w.ID,
+		ProjectID: w.ProjectID,
+		URL:       w.URL,
+		Secret:    w.Secret,
+		Events:    events,
+		Active:    w.Active,
+		CreatedAt: w.CreatedAt,
+		UpdatedAt: w.UpdatedAt,
+	}
+	if w.CreatedBy != uuid.Nil {
+		m.CreatedBy = &w.CreatedBy
+	}
+	return m
+}
+
+func (m Webhook) ToDomain() domain.Webhook {
+	events := make([]domain.TaskEventType, 0, len(m.Events))
+	for _, e := range m.Events {
+		events = append(events, domain.TaskEventType(e))
+	}
+	w := domain.Webhook{
+		ID:        m.ID,
+		ProjectID: m.ProjectID,
+		URL:       m.URL,
+		Secret:    m.Secret,
+		Events:    events,
+		Active:    m.Active,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.CreatedBy != nil {
+		w.CreatedBy = *m.CreatedBy
+	}
+	return w
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID `gorm:"primaryKey"`
+	WebhookID     uuid.UUID
+	EventID       uuid.UUID
+	EventType     string
+	Payload       string
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	ReplayOf      *uuid.UUID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+func NewWebhookDeliveryModel(d domain.WebhookDelivery) WebhookDelivery {
+	return WebhookDelivery{
+		ID:            d.ID,
+		WebhookID:     d.WebhookID,
+		EventID:       d.EventID,
+		EventType:     string(d.EventType),
+		Payload:       d.Payload,
+		Status:        string(d.Status),
+		Attempts:      d.Attempts,
+		NextAttemptAt: d.NextAttemptAt,
+		LastError:     d.LastError,
+		ReplayOf:      d.ReplayOf,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
+	}
+}
+
+func (m WebhookDelivery) ToDomain() domain.WebhookDelivery {
+	return domain.WebhookDelivery{
+		ID:            m.ID,
+		WebhookID:     m.WebhookID,
+		EventID:       m.EventID,
+		EventType:     domain.TaskEventType(m.EventType),
+		Payload:       m.Payload,
+		Status:        domain.DeliveryStatus(m.Status),
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+		ReplayOf:      m.ReplayOf,
+		CreatedAt:     m.CreatedAt,
+		UpdatedAt:     m.UpdatedAt,
+	}
+}
+
+type WebhookAttempt struct {
+	ID          uuid.UUID `gorm:"primaryKey"`
+	DeliveryID  uuid.UUID
+	Attempt     int
+	StatusCode  int
+	Error       string
+	DurationMs  int64
+	AttemptedAt time.Time
+}
+
+func NewWebhookAttemptModel(a domain.WebhookAttempt) WebhookAttempt {
+	return WebhookAttempt{
+		ID:          a.ID,
+		DeliveryID:  a.DeliveryID,
+		Attempt:     a.Attempt,
+		StatusCode:  a.StatusCode,
+		Error:       a.Error,
+		DurationMs:  a.Duration.Milliseconds(),
+		AttemptedAt: a.AttemptedAt,
+	}
+}
+
+func (m WebhookAttempt) ToDomain() domain.WebhookAttempt {
+	return domain.WebhookAttempt{
+		ID:          m.ID,
+		DeliveryID:  m.DeliveryID,
+		Attempt:     m.Attempt,
+		StatusCode:  m.StatusCode,
+		Error:       m.Error,
+		Duration:    time.Duration(m.DurationMs) * time.Millisecond,
+		AttemptedAt: m.AttemptedAt,
+	}
+}
@@ -0,0 +1,66 @@
+package model
+
+import (
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type WorkflowStatus struct {
+	ProjectID uuid.UUID `gorm:"primaryKey"`
+	Name      string    `gorm:"primaryKey"`
+	Category  string
+	Position  int
+	IsInitial bool
+}
+
+type WorkflowTransition struct {
+	ProjectID  uuid.UUID `gorm:"primaryKey"`
+	FromStatus string    `gorm:"primaryKey"`
+	ToStatus   string    `gorm:"primaryKey"`
+}
+
+func NewWorkflowModels(wf domain.Workflow) ([]WorkflowStatus, []WorkflowTransition) {
+	statuses := make([]WorkflowStatus, 0, len(wf.Statuses))
+	for i, st := range wf.Statuses {
+		statuses = append(statuses, WorkflowStatus{
+			ProjectID: wf.ProjectID,
+			Name:      st.Name,
+			Category:  string(st.Category),
+			Position:  i,
+			IsInitial: st.Name == wf.InitialStatus,
+		})
+	}
+
+	transitions := make([]WorkflowTransition, 0, len(wf.Transitions))
+	for _, tr := range wf.Transitions {
+		transitions = append(transitions, WorkflowTransition{
+			ProjectID:  wf.ProjectID,
+			FromStatus: tr.From,
+			ToStatus:   tr.To,
+		})
+	}
+
+	return statuses, transitions
+}
+
+func WorkflowToDomain(projectID uuid.UUID, statuses []WorkflowStatus, transitions []WorkflowTransition) domain.Workflow {
+	wf := domain.Workflow{
+		ProjectID:   projectID,
+		Statuses:    make([]domain.WorkflowStatus, 0, len(statuses)),
+		Transitions: make([]domain.WorkflowTransition, 0, len(transitions)),
+	}
+	for _, st := range statuses {
+		if st.IsInitial {
+			wf.InitialStatus = st.Name
+		}
+		wf.Statuses = append(wf.Statuses, domain.WorkflowStatus{
+			Name:     st.Name,
+			Category: domain.StatusCategory(st.Category),
+		})
+	}
+	for _, tr := range transitions {
+		wf.Transitions = append(wf.Transitions, domain.WorkflowTransition{From: tr.FromStatus, To: tr.ToStatus})
+	}
+	return wf
+}
@@ -0,0 +1,289 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *NotificationRepository {
+	return &NotificationRepository{db: db}
+}
+
+// Create stores a notification along with its pending deliveries. Creating a
+// notification that is already stored is a no-op.
+func (r *NotificationRepository) Create(ctx context.Context, n *domain.Notification, deliveries []domain.Delivery) error {
+	return conn(ctx, r.db).Transaction(func(db *gorm.DB) error {
+		m := model.NewNotificationModel(*n)
+		res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&m)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+
+		models := make([]model.NotificationDelivery, 0, len(deliveries))
+		for _, d := range deliveries {
+			models = append(models, model.NewNotificationDeliveryModel(d))
+		}
+		return db.Create(&models).Error
+	})
+}
+
+// ClaimDue leases up to limit pending deliveries that are due by pushing
+// their next attempt lease into the future, so that concurrent dispatchers
+// skip them, and loads their notifications. Deliveries that are not finished
+// when the lease runs out are tried again.
+func (r *NotificationRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.Delivery, error) {
+	var models []model.NotificationDelivery
+	if err := conn(ctx, r.db).Raw(`
+		UPDATE notification_deliveries SET next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM notification_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, string(domain.DeliveryPending), now, limit,
+	).Scan(&models).Error; err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.NotificationID)
+	}
+	var notifications []model.Notification
+	if err := conn(ctx, r.db).Where("id IN ?", ids).Find(&notifications).Error; err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]domain.Notification, len(notifications))
+	for _, n := range notifications {
+		byID[n.ID] = n.ToDomain()
+	}
+
+	deliveries := make([]domain.Delivery, 0, len(models))
+	for _, m := range models {
+		n, ok := byID[m.NotificationID]
+		if !ok {
+			continue
+		}
+		d := m.ToDomain()
+		d.Notification = &n
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt saves the outcome of a delivery attempt and logs the attempt.
+func (r *NotificationRepository) RecordAttempt(
+	ctx context.Context,
+	delivery *domain.Delivery,
+	attempt *domain.DeliveryAttempt,
+) error {
+	return conn(ctx, r.db).Transaction(func(db *gorm.DB) error {
+		a := model.NewNotificationAttemptModel(*attempt)
+		if err := db.Create(&a).Error; err != nil {
+			return err
+		}
+
+		delivery.UpdatedAt = attempt.AttemptedAt
+		return db.Model(&model.NotificationDelivery{}).
+			Where("id = ?", delivery.ID).
+			Updates(map[string]any{
+				"status":          string(delivery.Status),
+				"attempts":        delivery.Attempts,
+				"next_attempt_at": delivery.NextAttemptAt,
+				"last_error":      delivery.LastError,
+				"updated_at":      delivery.UpdatedAt,
+			}).Error
+	})
+}
+
+type NotificationPreferenceRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationPreferenceRepository(db *gorm.DB) *NotificationPreferenceRepository {
+	return &NotificationPreferenceRepository{db: db}
+}
+
+func (r *NotificationPreferenceRepository) Get(
+	ctx context.Context,
+	userID uuid.UUID,
+) (*domain.NotificationPreferences, error) {
+	var m model.NotificationPreferences
+	if err := conn(ctx, r.db).First(&m, "user_id = ?", userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	prefs := m.ToDomain()
+	return &prefs, nil
+}
+
+// Save creates or replaces a user's preferences.
+func (r *NotificationPreferenceRepository) Save(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	m := model.NewNotificationPreferencesModel(*prefs)
+	return conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "user_id"}},
+			UpdateAll: true,
+		}).
+		Create(&m).Error
+}
+
+type InboxRepository struct {
+	db *gorm.DB
+}
+
+func NewInboxRepository(db *gorm.DB) *InboxRepository {
+	return &InboxRepository{db: db}
+}
+
+// Add puts a notification in a user's inbox. Adding it again is a no-op, so
+// that retried deliveries do not duplicate it.
+func (r *InboxRepository) Add(ctx context.Context, userID, notificationID uuid.UUID, at time.Time) error {
+	return conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.InboxItem{NotificationID: notificationID, UserID: userID, CreatedAt: at}).Error
+}
+
+type inboxRow struct {
+	model.Notification
+	ReadAt    *time.Time
+	InboxedAt time.Time
+}
+
+// List returns one page of a user's inbox, newest first, and the cursor of
+// the next page (empty on the last page).
+func (r *InboxRepository) List(
+	ctx context.Context,
+	userID uuid.UUID,
+	unreadOnly bool,
+	page pagination.Request,
+) ([]domain.Notification, string, error) {
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := conn(ctx, r.db).
+		Table("inbox_items i").
+		Select("n.*, i.read_at, i.created_at AS inboxed_at").
+		Joins("JOIN notifications n ON n.id = i.notification_id").
+		Where("i.user_id = ?", userID)
+	if unreadOnly {
+		query = query.Where("i.read_at IS NULL")
+	}
+	if after != nil {
+		if after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, *after.Key)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		query = query.Where("(i.created_at, i.notification_id) < (?, ?)", createdAt, after.ID)
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var rows []inboxRow
+	if err := query.
+		Order("i.created_at desc, i.notification_id desc").
+		Limit(limit + 1).
+		Scan(&rows).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		key := last.InboxedAt.Format(time.RFC3339Nano)
+		next = pagination.Cursor{Key: &key, ID: last.ID}.Encode()
+	}
+
+	notifications := make([]domain.Notification, 0, len(rows))
+	for _, row := range rows {
+		n := row.Notification.ToDomain()
+		n.ReadAt = row.ReadAt
+		notifications = append(notifications, n)
+	}
+	return notifications, next, nil
+}
+
+// MarkRead marks the given notifications in a user's inbox read, or all of
+// them when ids is empty. Notifications already read keep their read time.
+func (r *InboxRepository) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, at time.Time) error {
+	query := conn(ctx, r.db).
+		Model(&model.InboxItem{}).
+		Where("user_id = ? AND read_at IS NULL", userID)
+	if len(ids) > 0 {
+		query = query.Where("notification_id IN ?", ids)
+	}
+	return query.Update("read_at", at).Error
+}
+
+type ReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewReminderRepository(db *gorm.DB) *ReminderRepository {
+	return &ReminderRepository{db: db}
+}
+
+// ListDueSoon returns assigned, uncompleted tasks due in (now, until] that
+// have not been reminded about for their current due date, soonest first.
+func (r *ReminderRepository) ListDueSoon(ctx context.Context, now, until time.Time) ([]domain.Task, error) {
+	var models []model.Task
+	if err := conn(ctx, r.db).
+		Where("completed_at IS NULL AND assigned_to <> ?", uuid.Nil).
+		Where("due_date > ? AND due_date <= ?", now, until).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM task_reminders tr WHERE tr.task_id = tasks.id AND tr.due_date = tasks.due_date
+		)`).
+		Order("due_date asc").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	tasks := make([]domain.Task, 0, len(models))
+	for _, m := range models {
+		tasks = append(tasks, m.ToDomain())
+	}
+	return tasks, nil
+}
+
+// MarkReminded records a reminder, returning false when one was already
+// recorded for the task and due date.
+func (r *ReminderRepository) MarkReminded(ctx context.Context, taskID uuid.UUID, dueDate, at time.Time) (bool, error) {
+	res := conn(ctx, r.db).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.TaskReminder{TaskID: taskID, DueDate: dueDate, SentAt: at})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	return res.RowsAffected > 0, nil
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"gorm.io/gorm"
+)
+
+type OutboxRepository struct {
+	db *gorm.DB
+}
+
+func NewOutboxRepository(db *gorm.DB) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Append stores events in the transaction of ctx, in the order given.
+func (r *OutboxRepository) Append(ctx context.Context, events ...domain.TaskEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+	now := time.Now()
+	models := make([]model.OutboxEvent, 0, len(events))
+	for _, e := range events {
+		models = append(models, model.NewOutboxEventModel(e, now))
+	}
+	return conn(ctx, r.db).Create(&models).Error
+}
+
+// ClaimDue leases up to limit due events that are the oldest remaining event
+// of their task by pushing their availability into the future, so that
+// concurrent relays skip them. Later events of a task are not claimed until
+// the earlier ones are relayed.
+func (r *OutboxRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.OutboxEvent, error) {
+	var models []model.OutboxEvent
+	if err := conn(ctx, r.db).Raw(`
+		UPDATE outbox_events SET available_at = ?
+		WHERE id IN (
+			SELECT e.id FROM outbox_events e
+			WHERE e.available_at <= ?
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_events earlier
+				WHERE earlier.task_id = e.task_id AND earlier.sequence < e.sequence
+			)
+			ORDER BY e.sequence
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, limit,
+	).Scan(&models).Error; err != nil {
+		return nil, err
+	}
+
+	events := make([]domain.OutboxEvent, 0, len(models))
+	for _, m := range models {
+		events = append(events, m.ToDomain())
+	}
+	return events, nil
+}
+
+// MarkRelayed removes a relayed event from the outbox.
+func (r *OutboxRepository) MarkRelayed(ctx context.Context, event *domain.OutboxEvent) error {
+	return conn(ctx, r.db).Delete(&model.OutboxEvent{}, "id = ?", event.Event.ID).Error
+}
+
+// Reschedule saves a failed attempt to relay an event.
+func (r *OutboxRepository) Reschedule(ctx context.Context, event *domain.OutboxEvent) error {
+	return conn(ctx, r.db).
+		Model(&model.OutboxEvent{}).
+		Where("id = ?", event.Event.ID).
+		Updates(map[string]any{
+			"attempts":     event.Attempts,
+			"available_at": event.AvailableAt,
+			"last_error":   event.LastError,
+		}).Error
+}
@@ -0,0 +1,148 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProjectRepository struct {
+	db *gorm.DB
+}
+
+func NewProjectRepository(db *gorm.DB) *ProjectRepository {
+	return &ProjectRepository{db: db}
+}
+
+// Create stores a project together with its owner's membership.
+func (r *ProjectRepository) Create(ctx context.Context, project *domain.Project, owner domain.ProjectMember) error {
+	err := conn(ctx, r.db).Transaction(func(db *gorm.DB) error {
+		m := model.NewProjectModel(*project)
+		if err := db.Create(&m).Error; err != nil {
+			return err
+		}
+		member := model.NewProjectMemberModel(owner)
+		return db.Create(&member).Error
+	})
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return fmt.Errorf("%w: project key %q is taken", domain.ErrConflict, project.Key)
+	}
+	return err
+}
+
+func (r *ProjectRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+	var m model.Project
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	p := m.ToDomain()
+	return &p, nil
+}
+
+// ListByMember lists the projects the user is a member of by name, leaving
+// out archived ones unless includeArchived is set.
+func (r *ProjectRepository) ListByMember(
+	ctx context.Context,
+	userID uuid.UUID,
+	includeArchived bool,
+) ([]domain.Project, error) {
+	query := conn(ctx, r.db).
+		Joins("JOIN project_members pm ON pm.project_id = projects.id").
+		Where("pm.user_id = ?", userID)
+	if !includeArchived {
+		query = query.Where("NOT projects.archived")
+	}
+
+	var models []model.Project
+	if err := query.Order("projects.name, projects.id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	projects := make([]domain.Project, 0, len(models))
+	for _, m := range models {
+		projects = append(projects, m.ToDomain())
+	}
+	return projects, nil
+}
+
+func (r *ProjectRepository) Update(ctx context.Context, project *domain.Project) error {
+	m := model.NewProjectModel(*project)
+	if err := conn(ctx, r.db).Save(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return fmt.Errorf("%w: project key %q is taken", domain.ErrConflict, project.Key)
+		}
+		return err
+	}
+	return nil
+}
+
+// Delete removes a project and its memberships. Projects that still have
+// tasks, including trashed ones, cannot be deleted.
+func (r *ProjectRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := conn(ctx, r.db).Delete(&model.Project{}, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: project still has tasks; archive it instead", domain.ErrConflict)
+		}
+		return err
+	}
+	return nil
+}
+
+// SaveMember adds a member or changes the role of an existing one.
+func (r *ProjectRepository) SaveMember(ctx context.Context, member *domain.ProjectMember) error {
+	m := model.NewProjectMemberModel(*member)
+	if err := conn(ctx, r.db).
+		Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "project_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role"}),
+		}).
+		Create(&m).Error; err != nil {
+		return err
+	}
+	*member = m.ToDomain()
+	return nil
+}
+
+func (r *ProjectRepository) GetMember(ctx context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	var m model.ProjectMember
+	if err := conn(ctx, r.db).
+		First(&m, "project_id = ? AND user_id = ?", projectID, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	member := m.ToDomain()
+	return &member, nil
+}
+
+func (r *ProjectRepository) ListMembers(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error) {
+	var models []model.ProjectMember
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("created_at, user_id").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	members := make([]domain.ProjectMember, 0, len(models))
+	for _, m := range models {
+		members = append(members, m.ToDomain())
+	}
+	return members, nil
+}
+
+func (r *ProjectRepository) RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error {
+	return conn(ctx, r.db).
+		Delete(&model.ProjectMember{}, "project_id = ? AND user_id = ?", projectID, userID).Error
+}
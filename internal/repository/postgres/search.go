@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SearchRepository struct {
+	db *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// searchSort tags search cursors, which walk (rank desc, hit_id asc).
+const searchSort = "rank"
+
+// searchHeadline marks matched terms in at most two short fragments.
+const searchHeadline = "StartSel=<mark>, StopSel=</mark>, MaxFragments=2, MaxWords=30, MinWords=10"
+
+type searchRow struct {
+	Kind      string
+	TaskID    uuid.UUID
+	CommentID *uuid.UUID
+	HitID     uuid.UUID
+	ProjectID uuid.UUID
+	Title     string
+	Snippet   string
+	Rank      float32
+}
+
+// Search matches the query against the search_vector columns of live tasks
+// and comments. Headlines are only computed for the rows of the page, from
+// HTML-escaped text, so that the marks are the only markup in a snippet.
+func (r *SearchRepository) Search(
+	ctx context.Context,
+	query domain.SearchQuery,
+	page pagination.Request,
+) ([]domain.SearchHit, string, error) {
+	args := map[string]any{"text": query.Text}
+
+	var taskFilter strings.Builder
+	if query.ProjectID != nil {
+		taskFilter.WriteString(" AND t.project_id = @project_id")
+		args["project_id"] = *query.ProjectID
+	}
+	if query.AssigneeID != nil {
+		taskFilter.WriteString(" AND t.assigned_to = @assignee_id")
+		args["assignee_id"] = *query.AssigneeID
+	}
+	if len(query.Statuses) > 0 {
+		taskFilter.WriteString(" AND t.status IN @statuses")
+		args["statuses"] = query.Statuses
+	}
+	if query.MemberID != nil {
+		taskFilter.WriteString(" AND t.project_id IN (SELECT project_id FROM project_members WHERE user_id = @member_id)")
+		args["member_id"] = *query.MemberID
+	}
+
+	keyset := "TRUE"
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if after != nil {
+		if after.Sort != searchSort || after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		rank, err := strconv.ParseFloat(*after.Key, 32)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		keyset = "(hits.rank < @after_rank OR (hits.rank = @after_rank AND hits.hit_id > @after_id))"
+		args["after_rank"] = float32(rank)
+		args["after_id"] = after.ID
+	}
+
+	limit := pagination.Limit(page.Limit)
+	args["limit"] = limit + 1
+	args["headline"] = searchHeadline
+
+	sql := `
+WITH query AS (SELECT websearch_to_tsquery('english', @text) AS q),
+hits AS (
+	SELECT 'task' AS kind, t.id AS task_id, NULL::uuid AS comment_id, t.id AS hit_id,
+		t.project_id, t.title, concat_ws(' ', t.title, t.description) AS body,
+		ts_rank_cd(t.search_vector, query.q) AS rank
+	FROM tasks t, query
+	WHERE t.deleted_at IS NULL AND t.search_vector @@ query.q` + taskFilter.String() + `
+	UNION ALL
+	SELECT 'comment', t.id, c.id, c.id,
+		t.project_id, t.title, c.content,
+		ts_rank_cd(c.search_vector, query.q)
+	FROM comments c JOIN tasks t ON t.id = c.task_id, query
+	WHERE c.deleted_at IS NULL AND t.deleted_at IS NULL AND c.search_vector @@ query.q` + taskFilter.String() + `
+)
+SELECT hits.kind, hits.task_id, hits.comment_id, hits.hit_id, hits.project_id, hits.title, hits.rank,
+	ts_headline('english', replace(replace(replace(hits.body, '&', '&amp;'), '<', '&lt;'), '>', '&gt;'), query.q, @headline) AS snippet
+FROM hits, query
+WHERE ` + keyset + `
+ORDER BY hits.rank DESC, hits.hit_id
+LIMIT @limit`
+
+	var rows []searchRow
+	if err := conn(ctx, r.db).Raw(sql, args).Scan(&rows).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[limit-1]
+		key := strconv.FormatFloat(float64(last.Rank), 'g', -1, 32)
+		next = pagination.Cursor{Sort: searchSort, Key: &key, ID: last.HitID}.Encode()
+	}
+
+	hits := make([]domain.SearchHit, 0, len(rows))
+	for _, row := range rows {
+		hits = append(hits, domain.SearchHit{
+			Kind:      domain.SearchKind(row.Kind),
+			TaskID:    row.TaskID,
+			CommentID: row.CommentID,
+			ProjectID: row.ProjectID,
+			Title:     row.Title,
+			Snippet:   row.Snippet,
+			Rank:      row.Rank,
+		})
+	}
+	return hits, next, nil
+}
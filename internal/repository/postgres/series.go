@@ -0,0 +1,114 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type SeriesRepository struct {
+	db *gorm.DB
+}
+
+func NewSeriesRepository(db *gorm.DB) *SeriesRepository {
+	return &SeriesRepository{db: db}
+}
+
+func (r *SeriesRepository) Create(ctx context.Context, series *domain.Series) error {
+	m := model.NewSeriesModel(*series)
+	if err := conn(ctx, r.db).Create(&m).Error; err != nil {
+		return err
+	}
+	*series = m.ToDomain()
+	return nil
+}
+
+func (r *SeriesRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	var m model.Series
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	s := m.ToDomain()
+	return &s, nil
+}
+
+// Update writes the rule, template and state of a series. It returns
+// domain.ErrVersionConflict when an occurrence was created since the series
+// was read, so that the scheduler's progress is never overwritten.
+func (r *SeriesRepository) Update(ctx context.Context, series *domain.Series) error {
+	m := model.NewSeriesModel(*series)
+	res := conn(ctx, r.db).
+		Model(&model.Series{}).
+		Where("id = ? AND current_task_id = ?", series.ID, series.CurrentTaskID).
+		Select("*").
+		Omit("id", "project_id", "created_by", "created_at").
+		Updates(&m)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrVersionConflict
+	}
+	series.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+// ListDue returns the active series whose next occurrence has started, or
+// whose latest occurrence has been completed.
+func (r *SeriesRepository) ListDue(ctx context.Context, now time.Time) ([]domain.Series, error) {
+	var models []model.Series
+	if err := conn(ctx, r.db).
+		Where("stopped_at IS NULL AND next_at IS NOT NULL").
+		Where(`next_at <= ? OR EXISTS (
+			SELECT 1 FROM tasks t WHERE t.id = task_series.current_task_id AND t.completed_at IS NOT NULL
+		)`, now).
+		Order("next_at").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	series := make([]domain.Series, 0, len(models))
+	for _, m := range models {
+		series = append(series, m.ToDomain())
+	}
+	return series, nil
+}
+
+// Advance records taskID, starting at at, as the latest occurrence and next
+// as the start of the one after it. It only succeeds while the next
+// occurrence is still from, returning domain.ErrConflict when another
+// scheduler run got there first or the series was stopped.
+func (r *SeriesRepository) Advance(
+	ctx context.Context,
+	id uuid.UUID,
+	from time.Time,
+	taskID uuid.UUID,
+	at time.Time,
+	next *time.Time,
+) error {
+	res := conn(ctx, r.db).
+		Model(&model.Series{}).
+		Where("id = ? AND next_at = ? AND stopped_at IS NULL", id, from).
+		Updates(map[string]any{
+			"current_task_id": taskID,
+			"last_at":         at,
+			"next_at":         next,
+			"updated_at":      time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrConflict
+	}
+	return nil
+}
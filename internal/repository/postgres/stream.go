@@ -0,0 +1,83 @@
+package postgres
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// streamLogLock is the advisory lock that serialises appends to the stream
+// log.
+const streamLogLock = 0x73747265616d // "stream"
+
+type StreamLogRepository struct {
+	db *gorm.DB
+}
+
+func NewStreamLogRepository(db *gorm.DB) *StreamLogRepository {
+	return &StreamLogRepository{db: db}
+}
+
+// Append logs an event unless it is already logged, and drops all but the
+// newest keep events. Appends take turns, so that positions commit in order
+// and a follower never reads past one that is still to commit.
+func (r *StreamLogRepository) Append(ctx context.Context, event domain.TaskEvent, keep int) error {
+	return conn(ctx, r.db).Transaction(func(db *gorm.DB) error {
+		if err := db.Exec("SELECT pg_advisory_xact_lock(?)", streamLogLock).Error; err != nil {
+			return err
+		}
+		m := model.NewStreamEventModel(event, time.Now())
+		if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&m).Error; err != nil {
+			return err
+		}
+		return db.Exec(
+			"DELETE FROM stream_events WHERE position <= (SELECT max(position) FROM stream_events) - ?",
+			keep,
+		).Error
+	})
+}
+
+// After returns up to limit events logged after position, oldest first, and
+// the position of the last one returned, or position when there are none.
+func (r *StreamLogRepository) After(ctx context.Context, position int64, limit int) ([]domain.TaskEvent, int64, error) {
+	var models []model.StreamEvent
+	if err := conn(ctx, r.db).
+		Where("position > ?", position).
+		Order("position").
+		Limit(limit).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	events, last := toStreamEvents(models, position)
+	return events, last, nil
+}
+
+// Latest returns the newest n events, oldest first, and the position of the
+// newest, or 0 when the log is empty.
+func (r *StreamLogRepository) Latest(ctx context.Context, n int) ([]domain.TaskEvent, int64, error) {
+	var models []model.StreamEvent
+	if err := conn(ctx, r.db).
+		Order("position DESC").
+		Limit(n).
+		Find(&models).Error; err != nil {
+		return nil, 0, err
+	}
+	slices.Reverse(models)
+	events, last := toStreamEvents(models, 0)
+	return events, last, nil
+}
+
+func toStreamEvents(models []model.StreamEvent, position int64) ([]domain.TaskEvent, int64) {
+	events := make([]domain.TaskEvent, 0, len(models))
+	for _, m := range models {
+		events = append(events, m.Payload)
+		position = m.Position
+	}
+	return events, position
+}
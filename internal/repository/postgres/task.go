@@ -2,9 +2,15 @@ package postgres
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"task-manager/domain"
 	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
@@ -20,7 +26,11 @@ func NewTaskRepository(db *gorm.DB) *TaskRepository {
 
 func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 	m := model.NewTaskModel(*task)
-	if err := r.db.WithContext(ctx).Create(&m).Error; err != nil {
+	m.Version = 1
+	if err := conn(ctx, r.db).Create(&m).Error; err != nil {
+		if errors.Is(err, gorm.ErrForeignKeyViolated) {
+			return fmt.Errorf("%w: project %s does not exist", domain.ErrInvalidTask, task.ProjectID)
+		}
 		return err
 	}
 	*task = m.ToDomain()
@@ -29,57 +39,538 @@ func (r *TaskRepository) Create(ctx context.Context, task *domain.Task) error {
 
 func (r *TaskRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
 	var m model.Task
-	if err := r.db.WithContext(ctx).First(&m, "id = ?", id).Error; err != nil {
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
 		return nil, err
 	}
-	d := m.ToDomain()
-	return &d, nil
+
+	tasks, err := r.toDomain(ctx, []model.Task{m})
+	if err != nil {
+		return nil, err
+	}
+	return &tasks[0], nil
 }
 
+// Update writes a task only if its stored version still equals task.Version,
+// returning domain.ErrVersionConflict otherwise. On success task.Version is
+// advanced to the new version.
 func (r *TaskRepository) Update(ctx context.Context, task *domain.Task) error {
 	m := model.NewTaskModel(*task)
-	return r.db.WithContext(ctx).Save(&m).Error
+	m.Version = task.Version + 1
+
+	res := conn(ctx, r.db).
+		Model(&model.Task{}).
+		Where("id = ? AND version = ?", task.ID, task.Version).
+		Select("*").
+		Omit("id", "created_at", "deleted_at", "deleted_by").
+		Updates(&m)
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrVersionConflict
+	}
+
+	task.Version = m.Version
+	task.UpdatedAt = m.UpdatedAt
+	return nil
+}
+
+// Delete moves a task and its comments to the trash.
+func (r *TaskRepository) Delete(ctx context.Context, id, deletedBy uuid.UUID, at time.Time) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Task{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"deleted_at": at,
+				"deleted_by": deletedBy,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return domain.ErrNotFound
+		}
+
+		return tx.Model(&model.Comment{}).
+			Where("task_id = ?", id).
+			Updates(map[string]any{"deleted_at": at, "deleted_by": deletedBy}).Error
+	})
+}
+
+// GetTrashedByID returns a task that is in the trash.
+func (r *TaskRepository) GetTrashedByID(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	var m model.Task
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Where("deleted_at IS NOT NULL").
+		First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	tasks, err := r.toDomain(ctx, []model.Task{m})
+	if err != nil {
+		return nil, err
+	}
+	return &tasks[0], nil
 }
 
-func (r *TaskRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	return r.db.WithContext(ctx).Delete(&model.Task{}, "id = ?", id).Error
+// ListTrashedByProject lists the trashed tasks of a project, most recently deleted first.
+func (r *TaskRepository) ListTrashedByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
+	var models []model.Task
+	if err := conn(ctx, r.db).
+		Unscoped().
+		Where("project_id = ?", projectID).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at desc").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toDomain(ctx, models)
+}
+
+// Restore takes a task out of the trash together with the comments that were
+// trashed along with it.
+func (r *TaskRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		var m model.Task
+		if err := tx.Unscoped().
+			Where("deleted_at IS NOT NULL").
+			First(&m, "id = ?", id).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return domain.ErrNotFound
+			}
+			return err
+		}
+
+		if err := tx.Unscoped().
+			Model(&model.Comment{}).
+			Where("task_id = ? AND deleted_at = ?", id, m.DeletedAt.Time).
+			Updates(map[string]any{"deleted_at": nil, "deleted_by": nil}).Error; err != nil {
+			return err
+		}
+
+		return tx.Unscoped().
+			Model(&model.Task{}).
+			Where("id = ?", id).
+			Updates(map[string]any{
+				"deleted_at": nil,
+				"deleted_by": nil,
+				"version":    gorm.Expr("version + 1"),
+			}).Error
+	})
+}
+
+// Purge permanently removes tasks and comments trashed before the given time
+// and returns the number of tasks removed. Subtasks are removed before their
+// parents so the parent_id constraint is never violated.
+func (r *TaskRepository) Purge(ctx context.Context, before time.Time) (int64, error) {
+	db := conn(ctx, r.db)
+
+	if err := db.Unscoped().
+		Where("deleted_at < ?", before).
+		Delete(&model.Comment{}).Error; err != nil {
+		return 0, err
+	}
+
+	var purged int64
+	for {
+		res := db.Unscoped().
+			Where("deleted_at < ?", before).
+			Where("NOT EXISTS (SELECT 1 FROM tasks child WHERE child.parent_id = tasks.id)").
+			Delete(&model.Task{})
+		if res.Error != nil {
+			return purged, res.Error
+		}
+		if res.RowsAffected == 0 {
+			return purged, nil
+		}
+		purged += res.RowsAffected
+	}
 }
 
 func (r *TaskRepository) Assign(ctx context.Context, taskID, userID uuid.UUID) error {
-	return r.db.WithContext(ctx).
+	return conn(ctx, r.db).
 		Model(&model.Task{}).
 		Where("id = ?", taskID).
-		Update("assigned_to", userID).Error
+		Updates(map[string]any{"assigned_to": userID, "version": gorm.Expr("version + 1")}).Error
 }
 
-func (r *TaskRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.Task, error) {
+// ListByUser returns one page of the tasks assigned to a user and the cursor
+// of the next page (empty on the last page).
+func (r *TaskRepository) ListByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter domain.TaskFilter,
+	sort domain.TaskSort,
+	page pagination.Request,
+) ([]domain.Task, string, error) {
+	return r.listPage(ctx, conn(ctx, r.db).Where("assigned_to = ?", userID), filter, sort, page)
+}
+
+// ListByProject returns one page of the tasks of a project and the cursor of
+// the next page (empty on the last page).
+func (r *TaskRepository) ListByProject(
+	ctx context.Context,
+	projectID uuid.UUID,
+	filter domain.TaskFilter,
+	sort domain.TaskSort,
+	page pagination.Request,
+) ([]domain.Task, string, error) {
+	return r.listPage(ctx, conn(ctx, r.db).Where("project_id = ?", projectID), filter, sort, page)
+}
+
+// ListSubtasks lists the direct children of a task, oldest first.
+func (r *TaskRepository) ListSubtasks(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error) {
 	var models []model.Task
-	if err := r.db.WithContext(ctx).
-		Where("assigned_to = ?", userID).
-		Order("created_at desc").
+	if err := conn(ctx, r.db).
+		Where("parent_id = ?", parentID).
+		Order("created_at asc").
 		Find(&models).Error; err != nil {
 		return nil, err
 	}
 
-	tasks := make([]domain.Task, 0, len(models))
-	for _, m := range models {
-		tasks = append(tasks, m.ToDomain())
+	return r.toDomain(ctx, models)
+}
+
+// ListOpenBySeries lists the uncompleted occurrences of a series, oldest first.
+func (r *TaskRepository) ListOpenBySeries(ctx context.Context, seriesID uuid.UUID) ([]domain.Task, error) {
+	var models []model.Task
+	if err := conn(ctx, r.db).
+		Where("series_id = ? AND completed_at IS NULL", seriesID).
+		Order("start_date asc, id asc").
+		Find(&models).Error; err != nil {
+		return nil, err
 	}
-	return tasks, nil
+
+	return r.toDomain(ctx, models)
 }
 
-func (r *TaskRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
+// SetSeries links a task to a series as one of its occurrences.
+func (r *TaskRepository) SetSeries(ctx context.Context, taskID, seriesID uuid.UUID) error {
+	res := conn(ctx, r.db).
+		Model(&model.Task{}).
+		Where("id = ?", taskID).
+		Updates(map[string]any{
+			"series_id":  seriesID,
+			"version":    gorm.Expr("version + 1"),
+			"updated_at": time.Now(),
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+// ListBlockedByProject lists uncompleted tasks of a project that wait on at
+// least one uncompleted blocker.
+func (r *TaskRepository) ListBlockedByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
 	var models []model.Task
-	if err := r.db.WithContext(ctx).
+	if err := conn(ctx, r.db).
 		Where("project_id = ?", projectID).
+		Where("completed_at IS NULL").
+		Where(`EXISTS (
+			SELECT 1 FROM task_dependencies d
+			JOIN tasks blocker ON blocker.id = d.blocker_id
+			WHERE d.blocked_id = tasks.id
+			  AND blocker.completed_at IS NULL
+			  AND blocker.deleted_at IS NULL
+		)`).
 		Order("created_at desc").
 		Find(&models).Error; err != nil {
 		return nil, err
 	}
 
+	return r.toDomain(ctx, models)
+}
+
+func (r *TaskRepository) ListOverdueByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]domain.Task, error) {
+	return r.listOpenDue(ctx, "assigned_to = ?", userID, nil, now)
+}
+
+func (r *TaskRepository) ListDueBetweenByUser(
+	ctx context.Context,
+	userID uuid.UUID,
+	from, to time.Time,
+) ([]domain.Task, error) {
+	return r.listOpenDue(ctx, "assigned_to = ?", userID, &from, to)
+}
+
+func (r *TaskRepository) ListOverdueByProject(
+	ctx context.Context,
+	projectID uuid.UUID,
+	now time.Time,
+) ([]domain.Task, error) {
+	return r.listOpenDue(ctx, "project_id = ?", projectID, nil, now)
+}
+
+func (r *TaskRepository) ListDueBetweenByProject(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]domain.Task, error) {
+	return r.listOpenDue(ctx, "project_id = ?", projectID, &from, to)
+}
+
+// listOpenDue lists tasks that are not completed and due before the given
+// time (and, when from is set, on or after from), soonest first.
+func (r *TaskRepository) listOpenDue(
+	ctx context.Context,
+	scope string,
+	scopeID uuid.UUID,
+	from *time.Time,
+	before time.Time,
+) ([]domain.Task, error) {
+	query := conn(ctx, r.db).
+		Where(scope, scopeID).
+		Where("completed_at IS NULL").
+		Where("due_date < ?", before)
+	if from != nil {
+		query = query.Where("due_date >= ?", *from)
+	}
+
+	var models []model.Task
+	if err := query.Order("due_date asc").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	return r.toDomain(ctx, models)
+}
+
+// applyTaskFilter narrows a tasks query by the given filter.
+func applyTaskFilter(query *gorm.DB, filter domain.TaskFilter) *gorm.DB {
+	if len(filter.LabelIDs) > 0 {
+		if filter.MatchAllLabels {
+			query = query.Where(`(
+				SELECT COUNT(DISTINCT tl.label_id) FROM task_labels tl
+				WHERE tl.task_id = tasks.id AND tl.label_id IN ?
+			) = ?`, filter.LabelIDs, len(uniqueIDs(filter.LabelIDs)))
+		} else {
+			query = query.Where(`EXISTS (
+				SELECT 1 FROM task_labels tl
+				WHERE tl.task_id = tasks.id AND tl.label_id IN ?
+			)`, filter.LabelIDs)
+		}
+	}
+	if len(filter.Statuses) > 0 {
+		query = query.Where("status IN ?", filter.Statuses)
+	}
+	if filter.AssigneeID != nil {
+		query = query.Where("assigned_to = ?", *filter.AssigneeID)
+	}
+	if filter.Text != "" {
+		pattern := "%" + likeEscaper.Replace(filter.Text) + "%"
+		query = query.Where("(title ILIKE ? OR description ILIKE ?)", pattern, pattern)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("created_at >= ?", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("created_at < ?", *filter.CreatedTo)
+	}
+	return query
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func uniqueIDs(ids []uuid.UUID) map[uuid.UUID]struct{} {
+	set := make(map[uuid.UUID]struct{}, len(ids))
+	for _, id := range ids {
+		set[id] = struct{}{}
+	}
+	return set
+}
+
+// taskSortColumns maps sort fields to columns. Priorities sort on the
+// generated priority_rank column so that "urgent" ranks above "low".
+var taskSortColumns = map[domain.TaskSortField]string{
+	domain.TaskSortCreatedAt: "created_at",
+	domain.TaskSortUpdatedAt: "updated_at",
+	domain.TaskSortDueDate:   "due_date",
+	domain.TaskSortPriority:  "priority_rank",
+}
+
+// nullableSortColumns lists the sort columns that may be NULL. Tasks without
+// a value come last in both directions. priority_rank is never NULL, since
+// priorities are validated.
+var nullableSortColumns = map[string]bool{"due_date": true}
+
+var priorityRanks = map[domain.Priority]int{
+	domain.PriorityLow:    0,
+	domain.PriorityMedium: 1,
+	domain.PriorityHigh:   2,
+	domain.PriorityUrgent: 3,
+}
+
+// listPage filters, orders and pages a tasks query using keyset pagination
+// on (sort column, id), in the order of the migration 011 and 022 indexes.
+func (r *TaskRepository) listPage(
+	ctx context.Context,
+	query *gorm.DB,
+	filter domain.TaskFilter,
+	sort domain.TaskSort,
+	page pagination.Request,
+) ([]domain.Task, string, error) {
+	if !sort.Field.Valid() {
+		sort = domain.DefaultTaskSort
+	}
+	column := taskSortColumns[sort.Field]
+	nullable := nullableSortColumns[column]
+	direction, cmp := "asc", ">"
+	if sort.Desc {
+		direction, cmp = "desc", "<"
+	}
+	sortKey := string(sort.Field) + " " + direction
+	order := fmt.Sprintf("%s %s, id %s", column, direction, direction)
+	if nullable {
+		order = fmt.Sprintf("%s %s NULLS LAST, id %s", column, direction, direction)
+	}
+
+	query = applyTaskFilter(query, filter)
+
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	if after != nil {
+		if after.Sort != sortKey {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		switch {
+		case after.Key == nil && nullable:
+			// Only rows with a NULL sort value remain after a NULL.
+			query = query.Where(fmt.Sprintf("%s IS NULL AND id %s ?", column, cmp), after.ID)
+		case after.Key == nil:
+			return nil, "", pagination.ErrInvalidCursor
+		default:
+			value, err := parseTaskSortKey(sort.Field, *after.Key)
+			if err != nil {
+				return nil, "", pagination.ErrInvalidCursor
+			}
+			if nullable {
+				query = query.Where(
+					fmt.Sprintf("(%[1]s %[2]s ? OR (%[1]s = ? AND id %[2]s ?) OR %[1]s IS NULL)", column, cmp),
+					value, value, after.ID,
+				)
+			} else {
+				query = query.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, cmp), value, after.ID)
+			}
+		}
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var models []model.Task
+	if err := query.
+		Order(order).
+		Limit(limit + 1).
+		Find(&models).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(models) > limit {
+		models = models[:limit]
+		last := models[limit-1]
+		next = pagination.Cursor{Sort: sortKey, Key: taskSortKey(sort.Field, last), ID: last.ID}.Encode()
+	}
+
+	tasks, err := r.toDomain(ctx, models)
+	if err != nil {
+		return nil, "", err
+	}
+	return tasks, next, nil
+}
+
+func taskSortKey(field domain.TaskSortField, m model.Task) *string {
+	var key string
+	switch field {
+	case domain.TaskSortUpdatedAt:
+		key = m.UpdatedAt.Format(time.RFC3339Nano)
+	case domain.TaskSortDueDate:
+		if m.DueDate == nil {
+			return nil
+		}
+		key = m.DueDate.Format(time.RFC3339Nano)
+	case domain.TaskSortPriority:
+		key = strconv.Itoa(priorityRanks[domain.Priority(m.Priority)])
+	default:
+		key = m.CreatedAt.Format(time.RFC3339Nano)
+	}
+	return &key
+}
+
+func parseTaskSortKey(field domain.TaskSortField, key string) (any, error) {
+	if field == domain.TaskSortPriority {
+		return strconv.Atoi(key)
+	}
+	return time.Parse(time.RFC3339Nano, key)
+}
+
+// toDomain converts task models and attaches their subtask rollups and labels.
+func (r *TaskRepository) toDomain(ctx context.Context, models []model.Task) ([]domain.Task, error) {
 	tasks := make([]domain.Task, 0, len(models))
+	if len(models) == 0 {
+		return tasks, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(models))
+	for _, m := range models {
+		ids = append(ids, m.ID)
+	}
+
+	var rollups []struct {
+		ParentID uuid.UUID
+		Total    int
+		Done     int
+	}
+	if err := conn(ctx, r.db).
+		Model(&model.Task{}).
+		Select("parent_id, COUNT(*) AS total, COUNT(completed_at) AS done").
+		Where("parent_id IN ?", ids).
+		Group("parent_id").
+		Scan(&rollups).Error; err != nil {
+		return nil, err
+	}
+
+	byParent := make(map[uuid.UUID]domain.SubtaskRollup, len(rollups))
+	for _, ru := range rollups {
+		byParent[ru.ParentID] = domain.SubtaskRollup{Total: ru.Total, Done: ru.Done}
+	}
+
+	var labels []struct {
+		TaskID uuid.UUID
+		model.Label
+	}
+	if err := conn(ctx, r.db).
+		Table("task_labels").
+		Select("task_labels.task_id, labels.*").
+		Joins("JOIN labels ON labels.id = task_labels.label_id").
+		Where("task_labels.task_id IN ?", ids).
+		Order("labels.name").
+		Scan(&labels).Error; err != nil {
+		return nil, err
+	}
+
+	byTask := make(map[uuid.UUID][]domain.Label)
+	for _, l := range labels {
+		byTask[l.TaskID] = append(byTask[l.TaskID], l.Label.ToDomain())
+	}
+
 	for _, m := range models {
-		tasks = append(tasks, m.ToDomain())
+		t := m.ToDomain()
+		t.Subtasks = byParent[m.ID]
+		t.Labels = byTask[m.ID]
+		tasks = append(tasks, t)
 	}
 	return tasks, nil
 }
@@ -0,0 +1,237 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type WebhookRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookRepository(db *gorm.DB) *WebhookRepository {
+	return &WebhookRepository{db: db}
+}
+
+func (r *WebhookRepository) Create(ctx context.Context, hook *domain.Webhook) error {
+	m := model.NewWebhookModel(*hook)
+	return conn(ctx, r.db).Create(&m).Error
+}
+
+func (r *WebhookRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	var m model.Webhook
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	hook := m.ToDomain()
+	return &hook, nil
+}
+
+func (r *WebhookRepository) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error) {
+	return r.find(conn(ctx, r.db).Where("project_id = ?", projectID))
+}
+
+// ListSubscribed returns the active webhooks of a project that subscribe to t.
+func (r *WebhookRepository) ListSubscribed(
+	ctx context.Context,
+	projectID uuid.UUID,
+	t domain.TaskEventType,
+) ([]domain.Webhook, error) {
+	events, err := json.Marshal([]domain.TaskEventType{t})
+	if err != nil {
+		return nil, err
+	}
+	return r.find(conn(ctx, r.db).
+		Where("project_id = ? AND active", projectID).
+		Where("events @> ?::jsonb", string(events)))
+}
+
+func (r *WebhookRepository) find(query *gorm.DB) ([]domain.Webhook, error) {
+	var models []model.Webhook
+	if err := query.Order("created_at, id").Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	hooks := make([]domain.Webhook, 0, len(models))
+	for _, m := range models {
+		hooks = append(hooks, m.ToDomain())
+	}
+	return hooks, nil
+}
+
+func (r *WebhookRepository) Update(ctx context.Context, hook *domain.Webhook) error {
+	m := model.NewWebhookModel(*hook)
+	return conn(ctx, r.db).Save(&m).Error
+}
+
+// Delete removes a webhook; its deliveries and attempts go with it.
+func (r *WebhookRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	return conn(ctx, r.db).Delete(&model.Webhook{}, "id = ?", id).Error
+}
+
+type WebhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+func NewWebhookDeliveryRepository(db *gorm.DB) *WebhookDeliveryRepository {
+	return &WebhookDeliveryRepository{db: db}
+}
+
+// Create stores deliveries, skipping those already stored.
+func (r *WebhookDeliveryRepository) Create(ctx context.Context, deliveries []domain.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	models := make([]model.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		models = append(models, model.NewWebhookDeliveryModel(d))
+	}
+	return conn(ctx, r.db).Clauses(clause.OnConflict{DoNothing: true}).Create(&models).Error
+}
+
+func (r *WebhookDeliveryRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	var m model.WebhookDelivery
+	if err := conn(ctx, r.db).First(&m, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+	d := m.ToDomain()
+	return &d, nil
+}
+
+// ListByWebhook returns one page of a webhook's deliveries, newest first,
+// and the cursor of the next page (empty on the last page).
+func (r *WebhookDeliveryRepository) ListByWebhook(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	page pagination.Request,
+) ([]domain.WebhookDelivery, string, error) {
+	after, err := pagination.Decode(page.Cursor)
+	if err != nil {
+		return nil, "", err
+	}
+
+	query := conn(ctx, r.db).Where("webhook_id = ?", webhookID)
+	if after != nil {
+		if after.Key == nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		createdAt, err := time.Parse(time.RFC3339Nano, *after.Key)
+		if err != nil {
+			return nil, "", pagination.ErrInvalidCursor
+		}
+		query = query.Where("(created_at, id) < (?, ?)", createdAt, after.ID)
+	}
+
+	limit := pagination.Limit(page.Limit)
+	var models []model.WebhookDelivery
+	if err := query.
+		Order("created_at desc, id desc").
+		Limit(limit + 1).
+		Find(&models).Error; err != nil {
+		return nil, "", err
+	}
+
+	var next string
+	if len(models) > limit {
+		models = models[:limit]
+		last := models[limit-1]
+		key := last.CreatedAt.Format(time.RFC3339Nano)
+		next = pagination.Cursor{Key: &key, ID: last.ID}.Encode()
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(models))
+	for _, m := range models {
+		deliveries = append(deliveries, m.ToDomain())
+	}
+	return deliveries, next, nil
+}
+
+func (r *WebhookDeliveryRepository) ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]domain.WebhookAttempt, error) {
+	var models []model.WebhookAttempt
+	if err := conn(ctx, r.db).
+		Where("delivery_id = ?", deliveryID).
+		Order("attempt").
+		Find(&models).Error; err != nil {
+		return nil, err
+	}
+
+	attempts := make([]domain.WebhookAttempt, 0, len(models))
+	for _, m := range models {
+		attempts = append(attempts, m.ToDomain())
+	}
+	return attempts, nil
+}
+
+// ClaimDue leases up to limit pending deliveries that are due by pushing
+// their next attempt into the future, so that concurrent dispatchers skip
+// them. Deliveries that are not finished when the lease runs out are tried
+// again.
+func (r *WebhookDeliveryRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.WebhookDelivery, error) {
+	var models []model.WebhookDelivery
+	if err := conn(ctx, r.db).Raw(`
+		UPDATE webhook_deliveries SET next_attempt_at = ?, updated_at = ?
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = ? AND next_attempt_at <= ?
+			ORDER BY next_attempt_at
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), now, string(domain.DeliveryPending), now, limit,
+	).Scan(&models).Error; err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(models))
+	for _, m := range models {
+		deliveries = append(deliveries, m.ToDomain())
+	}
+	return deliveries, nil
+}
+
+// RecordAttempt saves the outcome of a delivery attempt and logs the attempt.
+func (r *WebhookDeliveryRepository) RecordAttempt(
+	ctx context.Context,
+	delivery *domain.WebhookDelivery,
+	attempt *domain.WebhookAttempt,
+) error {
+	return conn(ctx, r.db).Transaction(func(db *gorm.DB) error {
+		a := model.NewWebhookAttemptModel(*attempt)
+		if err := db.Create(&a).Error; err != nil {
+			return err
+		}
+
+		delivery.UpdatedAt = attempt.AttemptedAt
+		return db.Model(&model.WebhookDelivery{}).
+			Where("id = ?", delivery.ID).
+			Updates(map[string]any{
+				"status":          string(delivery.Status),
+				"attempts":        delivery.Attempts,
+				"next_attempt_at": delivery.NextAttemptAt,
+				"last_error":      delivery.LastError,
+				"updated_at":      delivery.UpdatedAt,
+			}).Error
+	})
+}
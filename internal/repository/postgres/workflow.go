@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+
+	"task-manager/domain"
+	"task-manager/internal/repository/postgres/model"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type WorkflowRepository struct {
+	db *gorm.DB
+}
+
+func NewWorkflowRepository(db *gorm.DB) *WorkflowRepository {
+	return &WorkflowRepository{db: db}
+}
+
+// GetByProject returns the workflow defined for a project, or nil if it has none.
+func (r *WorkflowRepository) GetByProject(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error) {
+	var statuses []model.WorkflowStatus
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Order("position").
+		Find(&statuses).Error; err != nil {
+		return nil, err
+	}
+	if len(statuses) == 0 {
+		return nil, nil
+	}
+
+	var transitions []model.WorkflowTransition
+	if err := conn(ctx, r.db).
+		Where("project_id = ?", projectID).
+		Find(&transitions).Error; err != nil {
+		return nil, err
+	}
+
+	wf := model.WorkflowToDomain(projectID, statuses, transitions)
+	return &wf, nil
+}
+
+// Save replaces the workflow of a project.
+func (r *WorkflowRepository) Save(ctx context.Context, workflow *domain.Workflow) error {
+	statuses, transitions := model.NewWorkflowModels(*workflow)
+
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Delete(&model.WorkflowTransition{}, "project_id = ?", workflow.ProjectID).Error; err != nil {
+			return err
+		}
+		if err := tx.Delete(&model.WorkflowStatus{}, "project_id = ?", workflow.ProjectID).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&statuses).Error; err != nil {
+			return err
+		}
+		if len(transitions) == 0 {
+			return nil
+		}
+		return tx.Create(&transitions).Error
+	})
+}
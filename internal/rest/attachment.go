@@ -0,0 +1,218 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/dto"
+	"task-manager/internal/rest/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type AttachmentService interface {
+	Upload(ctx context.Context, taskID uuid.UUID, commentID *uuid.UUID, uploaderID uuid.UUID, fileName string, size int64, r io.Reader) (*domain.Attachment, error)
+	List(ctx context.Context, taskID uuid.UUID) ([]domain.Attachment, error)
+	Open(ctx context.Context, taskID, id uuid.UUID) (*domain.Attachment, io.ReadCloser, error)
+	Delete(ctx context.Context, taskID, id, userID uuid.UUID) error
+}
+
+// RegisterAttachmentRoutes registers attachment routes to the task router group.
+func RegisterAttachmentRoutes(rg *gin.RouterGroup, service AttachmentService) {
+	rg.GET("/:id/attachments", listAttachmentsHandler(service))
+	rg.POST("/:id/attachments", uploadAttachmentHandler(service))
+	rg.GET("/:id/attachments/:attachment_id", downloadAttachmentHandler(service))
+	rg.DELETE("/:id/attachments/:attachment_id", deleteAttachmentHandler(service))
+}
+
+// uploadAttachmentHandler uploads a file to a task
+//
+//	@Summary		Upload an attachment
+//	@Description	Streams the "file" part of a multipart form into storage. The media type is detected from the content, and the SHA-256 checksum is returned.
+//	@Tags			Attachments
+//	@Accept			mpfd
+//	@Produce		json
+//	@Param			id			path		string	true	"Task ID"
+//	@Param			comment_id	query		string	false	"Attach to this comment of the task"
+//	@Param			file		formData	file	true	"File to upload"
+//	@Success		201			{object}	dto.AttachmentResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		413			{object}	dto.ErrorResponse
+//	@Failure		415			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/attachments [post]
+//	@Security		BearerAuth
+func uploadAttachmentHandler(service AttachmentService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		var commentID *uuid.UUID
+		if raw := c.Query("comment_id"); raw != "" {
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid comment_id"})
+				return
+			}
+			commentID = &id
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		// Read the parts as they arrive instead of buffering the whole form.
+		reader, err := c.Request.MultipartReader()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "expected a multipart/form-data body"})
+			return
+		}
+		for {
+			part, err := reader.NextPart()
+			if errors.Is(err, io.EOF) {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "missing file part"})
+				return
+			}
+			if err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+				return
+			}
+			if part.FormName() != "file" {
+				_ = part.Close()
+				continue
+			}
+
+			attachment, err := service.Upload(c, taskID, commentID, userID, part.FileName(), -1, part)
+			_ = part.Close()
+			if err != nil {
+				c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+				return
+			}
+
+			c.JSON(http.StatusCreated, dto.NewAttachmentResponse(*attachment))
+			return
+		}
+	}
+}
+
+// listAttachmentsHandler lists the attachments of a task
+//
+//	@Summary		List task attachments
+//	@Description	Get the files attached to a task and its comments, oldest first
+//	@Tags			Attachments
+//	@Produce		json
+//	@Param			id	path		string	true	"Task ID"
+//	@Success		200	{array}		dto.AttachmentResponse
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		403	{object}	dto.ErrorResponse
+//	@Failure		404	{object}	dto.ErrorResponse
+//	@Failure		500	{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/attachments [get]
+//	@Security		BearerAuth
+func listAttachmentsHandler(service AttachmentService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		attachments, err := service.List(c, taskID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewAttachmentListResponse(attachments))
+	}
+}
+
+// downloadAttachmentHandler streams the content of an attachment
+//
+//	@Summary		Download an attachment
+//	@Description	Streams the file content. The ETag is the SHA-256 checksum of the content.
+//	@Tags			Attachments
+//	@Produce		octet-stream
+//	@Param			id				path		string	true	"Task ID"
+//	@Param			attachment_id	path		string	true	"Attachment ID"
+//	@Success		200				{file}		file
+//	@Failure		400				{object}	dto.ErrorResponse
+//	@Failure		403				{object}	dto.ErrorResponse
+//	@Failure		404				{object}	dto.ErrorResponse
+//	@Failure		500				{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/attachments/{attachment_id} [get]
+//	@Security		BearerAuth
+func downloadAttachmentHandler(service AttachmentService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		attachmentID, err := uuid.Parse(c.Param("attachment_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid attachment ID"})
+			return
+		}
+
+		attachment, content, err := service.Open(c, taskID, attachmentID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		defer content.Close()
+
+		c.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, content, map[string]string{
+			"Content-Disposition":    mime.FormatMediaType("attachment", map[string]string{"filename": attachment.FileName}),
+			"ETag":                   `"` + attachment.SHA256 + `"`,
+			"X-Content-Type-Options": "nosniff",
+			"X-Checksum-SHA256":      attachment.SHA256,
+		})
+	}
+}
+
+// deleteAttachmentHandler removes an attachment
+//
+//	@Summary		Delete an attachment
+//	@Description	The uploader or an administrator can delete an attachment
+//	@Tags			Attachments
+//	@Produce		json
+//	@Param			id				path		string	true	"Task ID"
+//	@Param			attachment_id	path		string	true	"Attachment ID"
+//	@Success		200				{object}	dto.SuccessResponse
+//	@Failure		400				{object}	dto.ErrorResponse
+//	@Failure		403				{object}	dto.ErrorResponse
+//	@Failure		404				{object}	dto.ErrorResponse
+//	@Failure		500				{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/attachments/{attachment_id} [delete]
+//	@Security		BearerAuth
+func deleteAttachmentHandler(service AttachmentService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		attachmentID, err := uuid.Parse(c.Param("attachment_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid attachment ID"})
+			return
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		if err := service.Delete(c, taskID, attachmentID, userID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "attachment deleted"})
+	}
+}
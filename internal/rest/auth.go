@@ -11,13 +11,17 @@ import (
 
 type AuthService interface {
 	Register(ctx context.Context, req dto.RegisterRequest) error
-	Login(ctx context.Context, req dto.LoginRequest) (string, error)
+	Login(ctx context.Context, req dto.LoginRequest) (*dto.LoginResponse, error)
+	Refresh(ctx context.Context, req dto.RefreshTokenRequest) (*dto.LoginResponse, error)
+	Logout(ctx context.Context, req dto.LogoutRequest) error
 }
 
 // RegisterAuthRoutes registers authentication-related routes (public, no middleware).
 func RegisterAuthRoutes(rg *gin.RouterGroup, service AuthService) {
 	rg.POST("/register", registerHandler(service))
 	rg.POST("/login", loginHandler(service))
+	rg.POST("/token/refresh", refreshTokenHandler(service))
+	rg.POST("/logout", logoutHandler(service))
 }
 
 // registerHandler handles user registration via Keycloak Admin API
@@ -48,7 +52,8 @@ func registerHandler(service AuthService) gin.HandlerFunc {
 	}
 }
 
-// loginHandler handles user login and returns a JWT access token
+// loginHandler handles user login and returns a JWT access token and a
+// refresh token
 //
 //	@Summary	Log in and get JWT token
 //	@Tags		Auth
@@ -67,12 +72,73 @@ func loginHandler(service AuthService) gin.HandlerFunc {
 			return
 		}
 
-		token, err := service.Login(c, req)
+		resp, err := service.Login(c, req)
 		if err != nil {
 			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
-		c.JSON(http.StatusOK, dto.LoginResponse{AccessToken: token})
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// refreshTokenHandler exchanges a refresh token for new tokens. The refresh
+// token in the response replaces the one sent.
+//
+//	@Summary	Refresh the JWT token
+//	@Tags		Auth
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		dto.RefreshTokenRequest	true	"Refresh token"
+//	@Success	200		{object}	dto.LoginResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	401		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/token/refresh [post]
+func refreshTokenHandler(service AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.RefreshTokenRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		resp, err := service.Refresh(c, req)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+// logoutHandler ends the Keycloak session of a refresh token. Access tokens
+// already issued stay valid until they expire.
+//
+//	@Summary	Log out
+//	@Tags		Auth
+//	@Accept		json
+//	@Produce	json
+//	@Param		request	body		dto.LogoutRequest	true	"Refresh token of the session"
+//	@Success	200		{object}	dto.SuccessResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	401		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/logout [post]
+func logoutHandler(service AuthService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var req dto.LogoutRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		if err := service.Logout(c, req); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "logged out successfully"})
 	}
 }
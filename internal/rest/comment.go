@@ -0,0 +1,221 @@
+package rest
+
+import (
+	"net/http"
+
+	"task-manager/dto"
+	"task-manager/internal/rest/middleware"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// commentOnTaskHandler comments on task
+//
+//	@Summary		Comment on a task
+//	@Description	Prefer POST /tasks/{id}/comments, which returns the comment
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string				true	"Task ID"
+//	@Param			request	body		dto.CommentRequest	true	"Comment content"
+//	@Success		200		{object}	dto.SuccessResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		404		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/comment [put]
+//	@Security		BearerAuth
+//	@Deprecated
+func commentOnTaskHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		var req dto.CommentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		if _, err := service.Comment(c, taskID, userID, req.Content, req.ParentID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "comment added"})
+	}
+}
+
+// listCommentsHandler lists the comments of a task
+//
+//	@Summary		List task comments
+//	@Description	Get the comments of a task, oldest first. Replies carry the ID of their parent comment.
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			id		path		string	true	"Task ID"
+//	@Param			limit	query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"next_cursor of the previous page"
+//	@Success		200		{object}	dto.CommentPageResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		404		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/comments [get]
+//	@Security		BearerAuth
+func listCommentsHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		comments, next, err := service.ListComments(c, taskID, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewCommentPageResponse(comments, next))
+	}
+}
+
+// createCommentHandler adds a comment or a reply to a task
+//
+//	@Summary	Comment on a task
+//	@Tags		Comments
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string				true	"Task ID"
+//	@Param		request	body		dto.CommentRequest	true	"Comment content and optional parent comment"
+//	@Success	201		{object}	dto.CommentResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/comments [post]
+//	@Security	BearerAuth
+func createCommentHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		var req dto.CommentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		comment, err := service.Comment(c, taskID, userID, req.Content, req.ParentID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.NewCommentResponse(*comment))
+	}
+}
+
+// editCommentHandler changes the content of a comment
+//
+//	@Summary		Edit a comment
+//	@Description	Only the author can edit a comment; edited comments carry edited_at
+//	@Tags			Comments
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"Task ID"
+//	@Param			comment_id	path		string					true	"Comment ID"
+//	@Param			request		body		dto.EditCommentRequest	true	"New content"
+//	@Success		200			{object}	dto.CommentResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/comments/{comment_id} [put]
+//	@Security		BearerAuth
+func editCommentHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		commentID, err := uuid.Parse(c.Param("comment_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid comment ID"})
+			return
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		var req dto.EditCommentRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		comment, err := service.EditComment(c, taskID, commentID, userID, req.Content)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewCommentResponse(*comment))
+	}
+}
+
+// deleteCommentHandler removes a comment
+//
+//	@Summary		Delete a comment
+//	@Description	The author or an administrator can delete a comment; its replies are kept
+//	@Tags			Comments
+//	@Produce		json
+//	@Param			id			path		string	true	"Task ID"
+//	@Param			comment_id	path		string	true	"Comment ID"
+//	@Success		200			{object}	dto.SuccessResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/comments/{comment_id} [delete]
+//	@Security		BearerAuth
+func deleteCommentHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+		commentID, err := uuid.Parse(c.Param("comment_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid comment ID"})
+			return
+		}
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		if err := service.DeleteComment(c, taskID, commentID, userID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "comment deleted"})
+	}
+}
@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/pkg/keycloak"
+	"task-manager/pkg/pagination"
+)
+
+// statusForError maps domain errors to HTTP status codes, falling back to 500.
+func statusForError(err error) int {
+	var transitionErr *domain.TransitionError
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, domain.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, keycloak.ErrInvalidGrant):
+		return http.StatusUnauthorized
+	case errors.Is(err, domain.ErrConflict), errors.Is(err, domain.ErrHasSubtasks),
+		errors.Is(err, domain.ErrDependencyCycle):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrAttachmentTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, domain.ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.As(err, &transitionErr):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, domain.ErrInvalidWorkflow), errors.Is(err, domain.ErrInvalidTask),
+		errors.Is(err, domain.ErrInvalidProject), errors.Is(err, domain.ErrInvalidLabel),
+		errors.Is(err, domain.ErrInvalidComment), errors.Is(err, domain.ErrInvalidAttachment),
+		errors.Is(err, domain.ErrInvalidRecurrence), errors.Is(err, domain.ErrInvalidPreferences),
+		errors.Is(err, domain.ErrInvalidWebhook), errors.Is(err, domain.ErrInvalidQuery),
+		errors.Is(err, pagination.ErrInvalidCursor):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,38 @@
+package rest
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setETag exposes a task version as a strong entity tag.
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", `"`+strconv.FormatInt(version, 10)+`"`)
+}
+
+// parseIfMatch reads the version expected by the optional If-Match header.
+// ok is false when the header is absent or "*".
+func parseIfMatch(c *gin.Context) (version int64, ok bool, err error) {
+	raw := strings.TrimSpace(c.GetHeader("If-Match"))
+	if raw == "" || raw == "*" {
+		return 0, false, nil
+	}
+
+	unquoted, found := strings.CutPrefix(raw, `"`)
+	if !found {
+		return 0, false, errors.New("If-Match must be a single strong entity tag")
+	}
+	unquoted, found = strings.CutSuffix(unquoted, `"`)
+	if !found {
+		return 0, false, errors.New("If-Match must be a single strong entity tag")
+	}
+
+	version, err = strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return 0, false, errors.New("If-Match does not match any task version")
+	}
+	return version, true, nil
+}
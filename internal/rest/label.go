@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type LabelService interface {
+	Create(ctx context.Context, projectID uuid.UUID, name, color string) (*domain.Label, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Label, error)
+	Update(ctx context.Context, id uuid.UUID, name, color *string) (*domain.Label, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+// RegisterProjectLabelRoutes registers the label routes nested under a project.
+func RegisterProjectLabelRoutes(rg *gin.RouterGroup, service LabelService) {
+	rg.GET("/:project_id/labels", listLabelsHandler(service))
+	rg.POST("/:project_id/labels", createLabelHandler(service))
+}
+
+// RegisterLabelRoutes registers label routes to the router group.
+func RegisterLabelRoutes(rg *gin.RouterGroup, service LabelService) {
+	rg.PUT("/:id", updateLabelHandler(service))
+	rg.DELETE("/:id", deleteLabelHandler(service))
+}
+
+// listLabelsHandler lists the labels of a project
+//
+//	@Summary	List project labels
+//	@Tags		Labels
+//	@Produce	json
+//	@Param		project_id	path		string	true	"Project ID"
+//	@Success	200			{array}		dto.LabelResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/projects/{project_id}/labels [get]
+//	@Security	BearerAuth
+func listLabelsHandler(service LabelService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		labels, err := service.ListByProject(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewLabelResponseList(labels))
+	}
+}
+
+// createLabelHandler defines a new label in a project
+//
+//	@Summary	Create a label
+//	@Tags		Labels
+//	@Accept		json
+//	@Produce	json
+//	@Param		project_id	path		string					true	"Project ID"
+//	@Param		request		body		dto.CreateLabelRequest	true	"Label to create"
+//	@Success	201			{object}	dto.LabelResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	409			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/projects/{project_id}/labels [post]
+//	@Security	BearerAuth
+func createLabelHandler(service LabelService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		var req dto.CreateLabelRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		label, err := service.Create(c, projectID, req.Name, req.Color)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.NewLabelResponse(*label))
+	}
+}
+
+// updateLabelHandler renames or recolors a label
+//
+//	@Summary	Update a label
+//	@Tags		Labels
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"Label ID"
+//	@Param		request	body		dto.UpdateLabelRequest	true	"Label changes"
+//	@Success	200		{object}	dto.LabelResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	409		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/labels/{id} [put]
+//	@Security	BearerAuth
+func updateLabelHandler(service LabelService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.UpdateLabelRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		label, err := service.Update(c, id, req.Name, req.Color)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewLabelResponse(*label))
+	}
+}
+
+// deleteLabelHandler deletes a label and detaches it from all tasks
+//
+//	@Summary	Delete a label
+//	@Tags		Labels
+//	@Produce	json
+//	@Param		id	path		string	true	"Label ID"
+//	@Success	200	{object}	dto.SuccessResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/labels/{id} [delete]
+//	@Security	BearerAuth
+func deleteLabelHandler(service LabelService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		if err := service.Delete(c, id); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "label deleted"})
+	}
+}
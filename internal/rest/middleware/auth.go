@@ -1,10 +1,10 @@
 package middleware
 
 import (
-	"crypto/rsa"
 	"net/http"
 	"strings"
 
+	"task-manager/pkg/authctx"
 	"task-manager/pkg/jwtutil"
 
 	"github.com/gin-gonic/gin"
@@ -12,28 +12,50 @@ import (
 
 const UserIDKey = "userID"
 
-func JWTAuthMiddleware(publicKey *rsa.PublicKey) gin.HandlerFunc {
+// JWTAuthMiddleware rejects requests without a valid bearer token, saying why
+// the token was refused.
+func JWTAuthMiddleware(validator *jwtutil.Validator) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if authHeader == "" || !strings.HasPrefix(authHeader, "Bearer ") {
+		tokenStr, ok := bearerToken(c)
+		if !ok {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing or invalid Authorization header"})
 			return
 		}
 
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-		claims, err := jwtutil.ValidateToken(tokenStr, publicKey)
+		claims, err := validator.Validate(tokenStr)
 		if err != nil {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token"})
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid token: " + err.Error()})
 			return
 		}
 
-		userID, ok := claims["sub"].(string)
-		if !ok {
+		principal, err := jwtutil.ParsePrincipal(claims)
+		if err != nil {
 			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "invalid subject claim"})
 			return
 		}
 
-		c.Set(UserIDKey, userID)
+		c.Set(UserIDKey, principal.UserID.String())
+		c.Request = c.Request.WithContext(authctx.WithPrincipal(c.Request.Context(), principal))
 		c.Next()
 	}
 }
+
+// bearerToken reads the token from the Authorization header. Browsers cannot
+// set headers on EventSource and WebSocket connections, so event streams may
+// pass it in the access_token query parameter instead.
+func bearerToken(c *gin.Context) (string, bool) {
+	authHeader := c.GetHeader("Authorization")
+	if strings.HasPrefix(authHeader, "Bearer ") {
+		return strings.TrimPrefix(authHeader, "Bearer "), true
+	}
+	if authHeader != "" || !isEventStream(c.Request) {
+		return "", false
+	}
+	token := c.Query("access_token")
+	return token, token != ""
+}
+
+func isEventStream(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket") ||
+		strings.Contains(r.Header.Get("Accept"), "text/event-stream")
+}
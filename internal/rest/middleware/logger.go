@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// accessTokenParam matches the value of the access_token query parameter that
+// event streams may authenticate with.
+var accessTokenParam = regexp.MustCompile(`([?&]access_token=)[^&]*`)
+
+// Logger logs requests like gin's default logger, but never writes the
+// access token of a query string to the log.
+func Logger() gin.HandlerFunc {
+	return gin.LoggerWithFormatter(func(p gin.LogFormatterParams) string {
+		if p.Latency > time.Minute {
+			p.Latency = p.Latency.Truncate(time.Second)
+		}
+		return fmt.Sprintf("[GIN] %v | %3d | %13v | %15s | %-7s %#v\n%s",
+			p.TimeStamp.Format("2006/01/02 - 15:04:05"),
+			p.StatusCode,
+			p.Latency,
+			p.ClientIP,
+			p.Method,
+			redactQuery(p.Path),
+			p.ErrorMessage,
+		)
+	})
+}
+
+// redactQuery replaces the access token in a request path with REDACTED.
+func redactQuery(path string) string {
+	return accessTokenParam.ReplaceAllString(path, "${1}REDACTED")
+}
@@ -0,0 +1,16 @@
+package middleware
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t,
+		"/api/v1/projects/p/events?access_token=REDACTED&last_event_id=e",
+		redactQuery("/api/v1/projects/p/events?access_token=eyJhbGciOi.payload.sig&last_event_id=e"),
+	)
+	assert.Equal(t, "/events?last_event_id=e&access_token=REDACTED", redactQuery("/events?last_event_id=e&access_token=x"))
+	assert.Equal(t, "/tasks?page_token=abc", redactQuery("/tasks?page_token=abc"))
+}
@@ -0,0 +1,187 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"task-manager/domain"
+	"task-manager/dto"
+	"task-manager/internal/rest/middleware"
+	"task-manager/pkg/pagination"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type NotificationService interface {
+	ListInbox(
+		ctx context.Context,
+		userID uuid.UUID,
+		unreadOnly bool,
+		page pagination.Request,
+	) ([]domain.Notification, string, error)
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error
+	Preferences(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error)
+	SetPreferences(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+// RegisterNotificationRoutes registers the caller's inbox and notification
+// preferences to the user router group.
+func RegisterNotificationRoutes(rg *gin.RouterGroup, service NotificationService) {
+	rg.GET("/me/notifications", listMyNotificationsHandler(service))
+	rg.POST("/me/notifications/read", markMyNotificationsReadHandler(service))
+	rg.GET("/me/notification-preferences", getMyNotificationPreferencesHandler(service))
+	rg.PUT("/me/notification-preferences", setMyNotificationPreferencesHandler(service))
+}
+
+// listMyNotificationsHandler returns the caller's in-app notifications
+//
+//	@Summary	List my notifications
+//	@Tags		Notifications
+//	@Produce	json
+//	@Param		unread	query		bool	false	"Only unread notifications"
+//	@Param		limit	query		int		false	"Page size (default 20, max 100)"
+//	@Param		cursor	query		string	false	"next_cursor of the previous page"
+//	@Success	200		{object}	dto.NotificationPageResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/users/me/notifications [get]
+//	@Security	BearerAuth
+func listMyNotificationsHandler(service NotificationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		unreadOnly := false
+		if raw := c.Query("unread"); raw != "" {
+			if unreadOnly, err = strconv.ParseBool(raw); err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid unread"})
+				return
+			}
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		notifications, next, err := service.ListInbox(c, userID, unreadOnly, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewNotificationPageResponse(notifications, next))
+	}
+}
+
+// markMyNotificationsReadHandler marks notifications in the caller's inbox read
+//
+//	@Summary		Mark my notifications read
+//	@Description	Marks the listed notifications read, or the whole inbox when ids is empty
+//	@Tags			Notifications
+//	@Accept			json
+//	@Param			request	body	dto.MarkNotificationsReadRequest	false	"Notifications to mark read"
+//	@Success		204
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		500	{object}	dto.ErrorResponse
+//	@Router			/users/me/notifications/read [post]
+//	@Security		BearerAuth
+func markMyNotificationsReadHandler(service NotificationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		var req dto.MarkNotificationsReadRequest
+		if c.Request.ContentLength != 0 {
+			if err := c.ShouldBindJSON(&req); err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+				return
+			}
+		}
+
+		if err := service.MarkRead(c, userID, req.IDs); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// getMyNotificationPreferencesHandler returns the caller's notification preferences
+//
+//	@Summary	Get my notification preferences
+//	@Tags		Notifications
+//	@Produce	json
+//	@Success	200	{object}	dto.NotificationPreferencesResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/users/me/notification-preferences [get]
+//	@Security	BearerAuth
+func getMyNotificationPreferencesHandler(service NotificationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		prefs, err := service.Preferences(c, userID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewNotificationPreferencesResponse(*prefs))
+	}
+}
+
+// setMyNotificationPreferencesHandler replaces the caller's notification preferences
+//
+//	@Summary		Set my notification preferences
+//	@Description	Chooses the channels (in_app, email, webhook) each event (assigned, mentioned, status_changed, due_soon) is delivered on. Email and webhook deliveries falling in the quiet hours wait until they end.
+//	@Tags			Notifications
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.NotificationPreferencesRequest	true	"Notification preferences"
+//	@Success		200		{object}	dto.NotificationPreferencesResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/users/me/notification-preferences [put]
+//	@Security		BearerAuth
+func setMyNotificationPreferencesHandler(service NotificationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		var req dto.NotificationPreferencesRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		prefs := req.ToDomain(userID)
+		if err := service.SetPreferences(c, &prefs); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewNotificationPreferencesResponse(prefs))
+	}
+}
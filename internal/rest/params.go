@@ -0,0 +1,136 @@
+package rest
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+// parseLocation reads the optional "tz" query parameter as an IANA time zone, defaulting to UTC.
+func parseLocation(c *gin.Context) (*time.Location, error) {
+	tz := c.DefaultQuery("tz", "UTC")
+	loc, err := time.LoadLocation(tz)
+	if err != nil {
+		return nil, errors.New("invalid time zone")
+	}
+	return loc, nil
+}
+
+// parseTimeRange reads the required RFC 3339 "from" and "to" query parameters.
+func parseTimeRange(c *gin.Context) (time.Time, time.Time, error) {
+	from, err := time.Parse(time.RFC3339, c.Query("from"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid or missing from (RFC 3339 expected)")
+	}
+	to, err := time.Parse(time.RFC3339, c.Query("to"))
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("invalid or missing to (RFC 3339 expected)")
+	}
+	if !to.After(from) {
+		return time.Time{}, time.Time{}, errors.New("to must be after from")
+	}
+	return from, to, nil
+}
+
+// parseTaskFilter reads the optional task list filters: "label_ids"
+// (comma-separated) with "label_match" (any or all, defaulting to any),
+// "status" (comma-separated), "assignee_id", "q" and the RFC 3339
+// "created_from" / "created_to" bounds.
+func parseTaskFilter(c *gin.Context) (domain.TaskFilter, error) {
+	var filter domain.TaskFilter
+
+	if raw := c.Query("label_ids"); raw != "" {
+		for _, part := range strings.Split(raw, ",") {
+			id, err := uuid.Parse(strings.TrimSpace(part))
+			if err != nil {
+				return domain.TaskFilter{}, errors.New("invalid label_ids")
+			}
+			filter.LabelIDs = append(filter.LabelIDs, id)
+		}
+	}
+
+	switch c.DefaultQuery("label_match", "any") {
+	case "any":
+	case "all":
+		filter.MatchAllLabels = true
+	default:
+		return domain.TaskFilter{}, errors.New("label_match must be any or all")
+	}
+
+	if raw := c.Query("status"); raw != "" {
+		for _, part := range strings.Split(raw, ",") {
+			filter.Statuses = append(filter.Statuses, strings.TrimSpace(part))
+		}
+	}
+
+	if raw := c.Query("assignee_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return domain.TaskFilter{}, errors.New("invalid assignee_id")
+		}
+		filter.AssigneeID = &id
+	}
+
+	filter.Text = strings.TrimSpace(c.Query("q"))
+
+	for _, bound := range []struct {
+		param string
+		dst   **time.Time
+	}{
+		{"created_from", &filter.CreatedFrom},
+		{"created_to", &filter.CreatedTo},
+	} {
+		raw := c.Query(bound.param)
+		if raw == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return domain.TaskFilter{}, errors.New("invalid " + bound.param + " (RFC 3339 expected)")
+		}
+		*bound.dst = &t
+	}
+
+	return filter, nil
+}
+
+// parseTaskSort reads the optional "sort" (created_at, updated_at, due_date or
+// priority) and "order" (asc or desc) query parameters. Without an order,
+// dates sort newest first, due dates soonest first and priorities highest first.
+func parseTaskSort(c *gin.Context) (domain.TaskSort, error) {
+	field := domain.TaskSortField(c.DefaultQuery("sort", string(domain.TaskSortCreatedAt)))
+	if !field.Valid() {
+		return domain.TaskSort{}, errors.New("sort must be one of created_at, updated_at, due_date, priority")
+	}
+
+	switch c.Query("order") {
+	case "":
+		return domain.TaskSort{Field: field, Desc: field != domain.TaskSortDueDate}, nil
+	case "asc":
+		return domain.TaskSort{Field: field}, nil
+	case "desc":
+		return domain.TaskSort{Field: field, Desc: true}, nil
+	default:
+		return domain.TaskSort{}, errors.New("order must be asc or desc")
+	}
+}
+
+// parsePage reads the optional "limit" and "cursor" query parameters of a paginated list.
+func parsePage(c *gin.Context) (pagination.Request, error) {
+	page := pagination.Request{Cursor: c.Query("cursor")}
+	if raw := c.Query("limit"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return pagination.Request{}, errors.New("limit must be a positive integer")
+		}
+		page.Limit = n
+	}
+	return page, nil
+}
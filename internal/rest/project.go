@@ -3,20 +3,65 @@ package rest
 import (
 	"context"
 	"net/http"
+	"strconv"
+	"time"
 
 	"task-manager/domain"
 	"task-manager/dto"
+	"task-manager/internal/rest/middleware"
+	"task-manager/pkg/pagination"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
 type ProjectService interface {
-	ListTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	Create(ctx context.Context, ownerID uuid.UUID, name, key, description string) (*domain.Project, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
+	ListForMember(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]domain.Project, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		name, description *string,
+		archived *bool,
+	) (*domain.Project, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListMembers(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error)
+	AddMember(
+		ctx context.Context,
+		projectID, userID uuid.UUID,
+		role domain.ProjectRole,
+	) (*domain.ProjectMember, error)
+	RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error
+	ListTasks(
+		ctx context.Context,
+		projectID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListBlockedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListTrashedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListOverdueTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListTasksDueThisWeek(ctx context.Context, projectID uuid.UUID, loc *time.Location) ([]domain.Task, error)
+	ListTasksDueBetween(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]domain.Task, error)
 }
 
 func RegisterProjectRoutes(rg *gin.RouterGroup, service ProjectService) {
+	rg.POST("", CreateProjectHandler(service))
+	rg.GET("", ListMyProjectsHandler(service))
+	rg.GET("/:project_id", GetProjectHandler(service))
+	rg.PUT("/:project_id", UpdateProjectHandler(service))
+	rg.DELETE("/:project_id", DeleteProjectHandler(service))
+	rg.GET("/:project_id/members", ListProjectMembersHandler(service))
+	rg.POST("/:project_id/members", AddProjectMemberHandler(service))
+	rg.DELETE("/:project_id/members/:user_id", RemoveProjectMemberHandler(service))
 	rg.GET("/:project_id/tasks", ListTasksByProjectHandler(service))
+	rg.GET("/:project_id/tasks/blocked", ListBlockedTasksByProjectHandler(service))
+	rg.GET("/:project_id/tasks/overdue", ListOverdueTasksByProjectHandler(service))
+	rg.GET("/:project_id/tasks/due-this-week", ListTasksDueThisWeekByProjectHandler(service))
+	rg.GET("/:project_id/tasks/due", ListTasksDueBetweenByProjectHandler(service))
+	rg.GET("/:project_id/trash", ListTrashedTasksByProjectHandler(service))
 }
 
 // ListTasksByProjectHandler handles GET /projects/:project_id/tasks
@@ -25,13 +70,137 @@ func RegisterProjectRoutes(rg *gin.RouterGroup, service ProjectService) {
 //	@Description	Get all tasks belonging to a specific project
 //	@Tags			Projects
 //	@Produce		json
+//	@Param			project_id		path		string	true	"Project ID"
+//	@Param			label_ids		query		string	false	"Comma-separated label IDs"
+//	@Param			label_match		query		string	false	"Match any or all of label_ids"	Enums(any, all)	default(any)
+//	@Param			assignee_id		query		string	false	"Assignee user ID"
+//	@Param			status			query		string	false	"Comma-separated statuses"
+//	@Param			q				query		string	false	"Text contained in the title or description"
+//	@Param			created_from	query		string	false	"Created at or after (RFC 3339)"
+//	@Param			created_to		query		string	false	"Created before (RFC 3339)"
+//	@Param			sort			query		string	false	"Sort field"													Enums(created_at, updated_at, due_date, priority)	default(created_at)
+//	@Param			order			query		string	false	"Sort order; defaults to asc for due_date and desc otherwise"	Enums(asc, desc)
+//	@Param			limit			query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	dto.TaskPageResponse
+//	@Failure		400				{object}	dto.ErrorResponse
+//	@Failure		403				{object}	dto.ErrorResponse
+//	@Failure		500				{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/tasks [get]
+//	@Security		BearerAuth
+func ListTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		filter, err := parseTaskFilter(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		sort, err := parseTaskSort(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, next, err := service.ListTasks(c, projectID, filter, sort, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskPageResponse(tasks, next))
+	}
+}
+
+// ListBlockedTasksByProjectHandler handles GET /projects/:project_id/tasks/blocked
+//
+//	@Summary		List blocked tasks by project
+//	@Description	Get uncompleted tasks of a project that still wait on an open blocker
+//	@Tags			Projects
+//	@Produce		json
 //	@Param			project_id	path		string	true	"Project ID"
 //	@Success		200			{array}		dto.TaskResponse
 //	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
 //	@Failure		500			{object}	dto.ErrorResponse
-//	@Router			/projects/{project_id}/tasks [get]
+//	@Router			/projects/{project_id}/tasks/blocked [get]
 //	@Security		BearerAuth
-func ListTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
+func ListBlockedTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		tasks, err := service.ListBlockedTasks(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// ListOverdueTasksByProjectHandler handles GET /projects/:project_id/tasks/overdue
+//
+//	@Summary		List overdue tasks by project
+//	@Description	Get uncompleted tasks of a project whose due date has passed
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Success		200			{array}		dto.TaskResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/tasks/overdue [get]
+//	@Security		BearerAuth
+func ListOverdueTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		tasks, err := service.ListOverdueTasks(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// ListTasksDueThisWeekByProjectHandler handles GET /projects/:project_id/tasks/due-this-week
+//
+//	@Summary		List tasks due this week by project
+//	@Description	Get uncompleted tasks of a project that are due in the current week (Monday to Sunday)
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Param			tz			query		string	false	"IANA time zone the week is computed in"	default(UTC)
+//	@Success		200			{array}		dto.TaskResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/tasks/due-this-week [get]
+//	@Security		BearerAuth
+func ListTasksDueThisWeekByProjectHandler(service ProjectService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		projectID, err := uuid.Parse(c.Param("project_id"))
 		if err != nil {
@@ -39,12 +208,375 @@ func ListTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
 			return
 		}
 
-		tasks, err := service.ListTasks(c, projectID)
+		loc, err := parseLocation(c)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, err := service.ListTasksDueThisWeek(c, projectID, loc)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
 	}
 }
+
+// ListTasksDueBetweenByProjectHandler handles GET /projects/:project_id/tasks/due
+//
+//	@Summary		List tasks due in a time range by project
+//	@Description	Get uncompleted tasks of a project that are due in [from, to)
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Param			from		query		string	true	"Range start (RFC 3339)"
+//	@Param			to			query		string	true	"Range end, exclusive (RFC 3339)"
+//	@Success		200			{array}		dto.TaskResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/tasks/due [get]
+//	@Security		BearerAuth
+func ListTasksDueBetweenByProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		from, to, err := parseTimeRange(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, err := service.ListTasksDueBetween(c, projectID, from, to)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// ListTrashedTasksByProjectHandler handles GET /projects/:project_id/trash
+//
+//	@Summary		List trashed tasks by project
+//	@Description	Get the deleted tasks of a project that have not been purged yet
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Success		200			{array}		dto.TaskResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/trash [get]
+//	@Security		BearerAuth
+func ListTrashedTasksByProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		tasks, err := service.ListTrashedTasks(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// CreateProjectHandler handles POST /projects
+//
+//	@Summary		Create a project
+//	@Description	Creates a project owned by the caller, who becomes its first member
+//	@Tags			Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			request	body		dto.CreateProjectRequest	true	"Project to create"
+//	@Success		201		{object}	dto.ProjectResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		409		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/projects [post]
+//	@Security		BearerAuth
+func CreateProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		var req dto.CreateProjectRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		project, err := service.Create(c, userID, req.Name, req.Key, req.Description)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusCreated, dto.NewProjectResponse(*project))
+	}
+}
+
+// ListMyProjectsHandler handles GET /projects
+//
+//	@Summary	List my projects
+//	@Tags		Projects
+//	@Produce	json
+//	@Param		include_archived	query		bool	false	"Include archived projects"
+//	@Success	200					{array}		dto.ProjectResponse
+//	@Failure	400					{object}	dto.ErrorResponse
+//	@Failure	500					{object}	dto.ErrorResponse
+//	@Router		/projects [get]
+//	@Security	BearerAuth
+func ListMyProjectsHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		includeArchived := false
+		if raw := c.Query("include_archived"); raw != "" {
+			if includeArchived, err = strconv.ParseBool(raw); err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid include_archived"})
+				return
+			}
+		}
+
+		projects, err := service.ListForMember(c, userID, includeArchived)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewProjectResponseList(projects))
+	}
+}
+
+// GetProjectHandler handles GET /projects/:project_id
+//
+//	@Summary	Get a project
+//	@Tags		Projects
+//	@Produce	json
+//	@Param		project_id	path		string	true	"Project ID"
+//	@Success	200			{object}	dto.ProjectResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	404			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/projects/{project_id} [get]
+//	@Security	BearerAuth
+func GetProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		project, err := service.GetByID(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewProjectResponse(*project))
+	}
+}
+
+// UpdateProjectHandler handles PUT /projects/:project_id
+//
+//	@Summary		Update a project
+//	@Description	Renames, describes, archives or unarchives a project. The key cannot change.
+//	@Tags			Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project_id	path		string						true	"Project ID"
+//	@Param			request		body		dto.UpdateProjectRequest	true	"Fields to change"
+//	@Success		200			{object}	dto.ProjectResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id} [put]
+//	@Security		BearerAuth
+func UpdateProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		var req dto.UpdateProjectRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		project, err := service.Update(c, projectID, req.Name, req.Description, req.Archived)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewProjectResponse(*project))
+	}
+}
+
+// DeleteProjectHandler handles DELETE /projects/:project_id
+//
+//	@Summary		Delete a project
+//	@Description	Deletes a project that has no tasks left, including trashed ones; archive it otherwise
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Success		200			{object}	dto.SuccessResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		409			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id} [delete]
+//	@Security		BearerAuth
+func DeleteProjectHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		if err := service.Delete(c, projectID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Project deleted successfully"})
+	}
+}
+
+// ListProjectMembersHandler handles GET /projects/:project_id/members
+//
+//	@Summary	List project members
+//	@Tags		Projects
+//	@Produce	json
+//	@Param		project_id	path		string	true	"Project ID"
+//	@Success	200			{array}		dto.ProjectMemberResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	404			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/projects/{project_id}/members [get]
+//	@Security	BearerAuth
+func ListProjectMembersHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		members, err := service.ListMembers(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewProjectMemberResponseList(members))
+	}
+}
+
+// AddProjectMemberHandler handles POST /projects/:project_id/members
+//
+//	@Summary		Add a project member
+//	@Description	Adds a user to the project, or changes the role of a member
+//	@Tags			Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project_id	path		string						true	"Project ID"
+//	@Param			request		body		dto.AddProjectMemberRequest	true	"Member to add"
+//	@Success		200			{object}	dto.ProjectMemberResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/members [post]
+//	@Security		BearerAuth
+func AddProjectMemberHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		var req dto.AddProjectMemberRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		member, err := service.AddMember(c, projectID, req.UserID, domain.ProjectRole(req.Role))
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewProjectMemberResponse(*member))
+	}
+}
+
+// RemoveProjectMemberHandler handles DELETE /projects/:project_id/members/:user_id
+//
+//	@Summary		Remove a project member
+//	@Description	Takes a user out of the project; the owner cannot be removed
+//	@Tags			Projects
+//	@Param			project_id	path	string	true	"Project ID"
+//	@Param			user_id		path	string	true	"User ID"
+//	@Success		204
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		403	{object}	dto.ErrorResponse
+//	@Failure		404	{object}	dto.ErrorResponse
+//	@Failure		500	{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/members/{user_id} [delete]
+//	@Security		BearerAuth
+func RemoveProjectMemberHandler(service ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		if err := service.RemoveMember(c, projectID, userID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type RecurrenceService interface {
+	SetRecurrence(ctx context.Context, taskID uuid.UUID, rule, timezone string) (*domain.Series, error)
+	Recurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error)
+	StopRecurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error)
+	UpdateSeries(ctx context.Context, task *domain.Task) error
+}
+
+// RegisterRecurrenceRoutes registers recurrence routes to the task router group.
+func RegisterRecurrenceRoutes(rg *gin.RouterGroup, service RecurrenceService, taskSvc TaskService) {
+	rg.GET("/:id/recurrence", getRecurrenceHandler(service))
+	rg.PUT("/:id/recurrence", setRecurrenceHandler(service))
+	rg.DELETE("/:id/recurrence", stopRecurrenceHandler(service))
+	rg.PUT("/:id/series", updateSeriesHandler(service, taskSvc))
+}
+
+// getRecurrenceHandler returns the series of a recurring task
+//
+//	@Summary	Get the recurrence of a task
+//	@Tags		Recurrence
+//	@Produce	json
+//	@Param		id	path		string	true	"Task ID"
+//	@Success	200	{object}	dto.SeriesResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/recurrence [get]
+//	@Security	BearerAuth
+func getRecurrenceHandler(service RecurrenceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		series, err := service.Recurrence(c, taskID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewSeriesResponse(*series))
+	}
+}
+
+// setRecurrenceHandler makes a task recur
+//
+//	@Summary		Make a task recur
+//	@Description	Starts a series with the task as its first occurrence, or replaces the rule of the task's active series. Supports the RFC 5545 RRULE parts FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY and BYMONTH. The task needs a start date.
+//	@Tags			Recurrence
+//	@Accept			json
+//	@Produce		json
+//	@Param			id		path		string					true	"Task ID"
+//	@Param			request	body		dto.RecurrenceRequest	true	"Recurrence rule and timezone"
+//	@Success		200		{object}	dto.SeriesResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		404		{object}	dto.ErrorResponse
+//	@Failure		412		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/recurrence [put]
+//	@Security		BearerAuth
+func setRecurrenceHandler(service RecurrenceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.RecurrenceRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		series, err := service.SetRecurrence(c, taskID, req.RRule, req.Timezone)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewSeriesResponse(*series))
+	}
+}
+
+// stopRecurrenceHandler stops the series of a task
+//
+//	@Summary		Stop a recurring task
+//	@Description	No further occurrences are created; existing occurrences are kept
+//	@Tags			Recurrence
+//	@Produce		json
+//	@Param			id	path		string	true	"Task ID"
+//	@Success		200	{object}	dto.SeriesResponse
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		403	{object}	dto.ErrorResponse
+//	@Failure		404	{object}	dto.ErrorResponse
+//	@Failure		412	{object}	dto.ErrorResponse
+//	@Failure		500	{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/recurrence [delete]
+//	@Security		BearerAuth
+func stopRecurrenceHandler(service RecurrenceService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		series, err := service.StopRecurrence(c, taskID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewSeriesResponse(*series))
+	}
+}
+
+// updateSeriesHandler updates an occurrence and the whole series
+//
+//	@Summary		Update a whole series
+//	@Description	Updates the occurrence like PUT /tasks/{id}, then applies its title, description, priority and assignee to future occurrences and to the other open ones. PUT /tasks/{id} only changes this occurrence.
+//	@Tags			Recurrence
+//	@Accept			json
+//	@Produce		json
+//	@Param			id			path		string					true	"Task ID of an occurrence"
+//	@Param			If-Match	header		string					false	"ETag of the version being updated"
+//	@Param			request		body		dto.UpdateTaskRequest	true	"Updated task data"
+//	@Success		200			{object}	dto.TaskResponse
+//	@Header			200			{string}	ETag	"Version of the updated task"
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		412			{object}	dto.ErrorResponse
+//	@Failure		422			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/series [put]
+//	@Security		BearerAuth
+func updateSeriesHandler(service RecurrenceService, taskSvc TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.UpdateTaskRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		expectedVersion, hasIfMatch, err := parseIfMatch(c)
+		if err != nil {
+			c.JSON(http.StatusPreconditionFailed, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		existing, err := taskSvc.GetByID(c, id)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+		if hasIfMatch {
+			existing.Version = expectedVersion
+		}
+
+		applyTaskUpdate(existing, req)
+
+		if err := service.UpdateSeries(c, existing); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		setETag(c, existing.Version)
+		c.JSON(http.StatusOK, dto.NewTaskResponse(*existing))
+	}
+}
@@ -0,0 +1,85 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"task-manager/domain"
+	"task-manager/dto"
+	"task-manager/pkg/pagination"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type SearchService interface {
+	Search(ctx context.Context, query domain.SearchQuery, page pagination.Request) ([]domain.SearchHit, string, error)
+}
+
+// RegisterSearchRoutes registers search routes to the router group.
+func RegisterSearchRoutes(rg *gin.RouterGroup, service SearchService) {
+	rg.GET("", searchHandler(service))
+}
+
+// searchHandler searches task titles, descriptions and comments
+//
+//	@Summary		Search tasks and comments
+//	@Description	Full-text search over task titles, descriptions and comments, best match first. Snippets are HTML: the text is escaped and matched terms are wrapped in <mark></mark>. Titles are plain text.
+//	@Tags			Search
+//	@Produce		json
+//	@Param			q			query		string	true	"Search text (quoted phrases, or, -excluded words)"
+//	@Param			project_id	query		string	false	"Project ID"
+//	@Param			assignee_id	query		string	false	"Assignee user ID"
+//	@Param			status		query		string	false	"Comma-separated task statuses"
+//	@Param			limit		query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor		query		string	false	"next_cursor of the previous page"
+//	@Success		200			{object}	dto.SearchPageResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/search [get]
+//	@Security		BearerAuth
+func searchHandler(service SearchService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		query := domain.SearchQuery{Text: c.Query("q")}
+
+		for _, param := range []struct {
+			name string
+			dst  **uuid.UUID
+		}{
+			{"project_id", &query.ProjectID},
+			{"assignee_id", &query.AssigneeID},
+		} {
+			raw := c.Query(param.name)
+			if raw == "" {
+				continue
+			}
+			id, err := uuid.Parse(raw)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid " + param.name})
+				return
+			}
+			*param.dst = &id
+		}
+
+		if raw := c.Query("status"); raw != "" {
+			for _, part := range strings.Split(raw, ",") {
+				query.Statuses = append(query.Statuses, strings.TrimSpace(part))
+			}
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		hits, next, err := service.Search(c, query, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewSearchPageResponse(hits, next))
+	}
+}
@@ -3,6 +3,8 @@ package rest
 import (
 	"log"
 
+	"task-manager/internal/rest/middleware"
+
 	"github.com/gin-gonic/gin"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
@@ -18,8 +20,21 @@ func NewServer(
 	taskSvc TaskService,
 	userSvc UserService,
 	projectSvc ProjectService,
+	workflowSvc WorkflowService,
+	labelSvc LabelService,
+	searchSvc SearchService,
+	attachmentSvc AttachmentService,
+	recurrenceSvc RecurrenceService,
+	notificationSvc NotificationService,
+	webhookSvc WebhookService,
+	eventStream EventStream,
 ) *Server {
-	r := gin.Default()
+	// Event streams may carry the access token in the query string, which the
+	// default logger would write out.
+	r := gin.New()
+	r.Use(middleware.Logger(), gin.Recovery())
+	// Let handlers pass *gin.Context as a context.Context carrying request values.
+	r.ContextWithFallback = true
 
 	r.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
@@ -29,12 +44,28 @@ func NewServer(
 
 	taskGroup := api.Group("/tasks", jwtMiddleware)
 	RegisterTaskRoutes(taskGroup, taskSvc)
+	RegisterAttachmentRoutes(taskGroup, attachmentSvc)
+	RegisterRecurrenceRoutes(taskGroup, recurrenceSvc, taskSvc)
 
 	userGroup := api.Group("/users", jwtMiddleware)
 	RegisterUserRoutes(userGroup, userSvc)
+	RegisterNotificationRoutes(userGroup, notificationSvc)
 
 	projectGroup := api.Group("/projects", jwtMiddleware)
 	RegisterProjectRoutes(projectGroup, projectSvc)
+	RegisterWorkflowRoutes(projectGroup, workflowSvc)
+	RegisterProjectLabelRoutes(projectGroup, labelSvc)
+	RegisterProjectWebhookRoutes(projectGroup, webhookSvc)
+	RegisterProjectEventRoutes(projectGroup, eventStream, projectSvc)
+
+	labelGroup := api.Group("/labels", jwtMiddleware)
+	RegisterLabelRoutes(labelGroup, labelSvc)
+
+	webhookGroup := api.Group("/webhooks", jwtMiddleware)
+	RegisterWebhookRoutes(webhookGroup, webhookSvc)
+
+	searchGroup := api.Group("/search", jwtMiddleware)
+	RegisterSearchRoutes(searchGroup, searchSvc)
 
 	return &Server{engine: r}
 }
@@ -0,0 +1,286 @@
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// heartbeatInterval is how often an idle stream is kept alive, so that
+	// proxies do not time it out and clients notice a dead connection.
+	heartbeatInterval = 15 * time.Second
+	// sseRetry is how long an EventSource waits before reconnecting.
+	sseRetry = 3 * time.Second
+	// wsWriteTimeout bounds every write to a WebSocket.
+	wsWriteTimeout = 10 * time.Second
+	// accessCheckInterval is how often an open stream checks that the caller
+	// may still read the project, so that removed members stop receiving
+	// events.
+	accessCheckInterval = time.Minute
+
+	// resetEvent tells a client that it missed events and should reload the
+	// project.
+	resetEvent = "reset"
+)
+
+type EventStream interface {
+	Subscribe(
+		projectID uuid.UUID,
+		lastEventID uuid.UUID,
+	) (events <-chan domain.TaskEvent, missed bool, cancel func())
+}
+
+// RegisterProjectEventRoutes registers the live task event streams nested
+// under a project. Browsers may open the WebSocket from the API's own origin
+// and from those listed in STREAM_ALLOWED_ORIGINS, comma-separated.
+func RegisterProjectEventRoutes(rg *gin.RouterGroup, service EventStream, projects ProjectService) {
+	upgrader := &websocket.Upgrader{CheckOrigin: allowOrigins(splitOrigins(os.Getenv("STREAM_ALLOWED_ORIGINS")))}
+
+	rg.GET("/:project_id/events", streamProjectEventsHandler(service, projects))
+	rg.GET("/:project_id/events/ws", projectEventsSocketHandler(service, projects, upgrader))
+}
+
+// allowOrigins accepts WebSocket handshakes without an Origin, which do not
+// come from browsers, from the API's own origin and from the allowed ones.
+// Since the token may be in the URL, any other page could otherwise open a
+// socket on the user's behalf.
+func allowOrigins(allowed []string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" || slices.Contains(allowed, origin) {
+			return true
+		}
+		u, err := url.Parse(origin)
+		return err == nil && strings.EqualFold(u.Host, r.Host)
+	}
+}
+
+func splitOrigins(raw string) []string {
+	var origins []string
+	for _, o := range strings.Split(raw, ",") {
+		if o = strings.TrimRight(strings.TrimSpace(o), "/"); o != "" {
+			origins = append(origins, o)
+		}
+	}
+	return origins
+}
+
+// mayStillRead reports whether the caller can still read the project. Only a
+// refusal ends the stream; other errors are retried on the next check.
+func mayStillRead(c *gin.Context, projects ProjectService, projectID uuid.UUID) bool {
+	_, err := projects.GetByID(c, projectID)
+	return !errors.Is(err, domain.ErrForbidden) && !errors.Is(err, domain.ErrNotFound)
+}
+
+// streamProjectEventsHandler streams the task events of a project as Server-Sent Events
+//
+//	@Summary		Stream project task events
+//	@Description	Pushes task.created, task.updated, task.assigned, task.commented, comment.edited, comment.deleted and task.deleted events as Server-Sent Events with the event ID as "id", the type as "event" and a dto.TaskEventResponse as "data". A comment is sent as a heartbeat every 15 seconds. Reconnecting with Last-Event-ID replays the events after it; a "reset" event means they are no longer available and the client should reload the project. Browsers that cannot set the Authorization header may pass the token as access_token. The stream ends once the caller can no longer read the project.
+//	@Tags			Projects
+//	@Produce		text/event-stream
+//	@Param			project_id		path		string	true	"Project ID"
+//	@Param			Last-Event-ID	header		string	false	"ID of the last event received"
+//	@Param			last_event_id	query		string	false	"Same as Last-Event-ID, for the first connection"
+//	@Param			access_token	query		string	false	"Access token, when the Authorization header cannot be set"
+//	@Success		200				{object}	dto.TaskEventResponse
+//	@Failure		400				{object}	dto.ErrorResponse
+//	@Failure		401				{object}	dto.ErrorResponse
+//	@Failure		403				{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/events [get]
+//	@Security		BearerAuth
+func streamProjectEventsHandler(service EventStream, projects ProjectService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, lastEventID, ok := parseEventStreamParams(c, projects)
+		if !ok {
+			return
+		}
+
+		events, missed, cancel := service.Subscribe(projectID, lastEventID)
+		defer cancel()
+
+		c.Header("Content-Type", "text/event-stream")
+		c.Header("Cache-Control", "no-cache")
+		c.Header("Connection", "keep-alive")
+		// Stop nginx from buffering the stream.
+		c.Header("X-Accel-Buffering", "no")
+		c.Status(http.StatusOK)
+
+		fmt.Fprintf(c.Writer, "retry: %d\n\n", sseRetry.Milliseconds())
+		if missed {
+			fmt.Fprintf(c.Writer, "event: %s\ndata: {}\n\n", resetEvent)
+		}
+		c.Writer.Flush()
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+		accessCheck := time.NewTicker(accessCheckInterval)
+		defer accessCheck.Stop()
+
+		for {
+			select {
+			case <-c.Request.Context().Done():
+				return
+			case <-accessCheck.C:
+				if !mayStillRead(c, projects, projectID) {
+					return
+				}
+				continue
+			case <-heartbeat.C:
+				fmt.Fprint(c.Writer, ": heartbeat\n\n")
+			case e, open := <-events:
+				if !open {
+					// Fell behind; the client reconnects and catches up.
+					return
+				}
+				data, err := json.Marshal(dto.NewTaskEventResponse(e))
+				if err != nil {
+					return
+				}
+				fmt.Fprintf(c.Writer, "id: %s\nevent: %s\ndata: %s\n\n", e.ID, e.Type, data)
+			}
+			c.Writer.Flush()
+		}
+	}
+}
+
+// projectEventsSocketHandler streams the task events of a project over a WebSocket
+//
+//	@Summary		Stream project task events over a WebSocket
+//	@Description	Upgrades to a WebSocket that receives every task event of the project as a dto.TaskEventResponse text message, and {"type":"reset"} when the events after last_event_id are no longer available. The server pings every 15 seconds and closes connections that do not answer, and closes the socket once the caller can no longer read the project. Browsers pass the token as access_token, from the API's origin or one listed in STREAM_ALLOWED_ORIGINS.
+//	@Tags			Projects
+//	@Param			project_id		path	string	true	"Project ID"
+//	@Param			last_event_id	query	string	false	"ID of the last event received, to resume after it"
+//	@Param			access_token	query	string	false	"Access token, when the Authorization header cannot be set"
+//	@Success		101
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		401	{object}	dto.ErrorResponse
+//	@Failure		403	{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/events/ws [get]
+//	@Security		BearerAuth
+func projectEventsSocketHandler(service EventStream, projects ProjectService, upgrader *websocket.Upgrader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, lastEventID, ok := parseEventStreamParams(c, projects)
+		if !ok {
+			return
+		}
+
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			// The upgrader has already replied.
+			return
+		}
+		defer conn.Close()
+
+		events, missed, cancel := service.Subscribe(projectID, lastEventID)
+		defer cancel()
+
+		// Clients only send pongs and close frames; read them until the
+		// connection goes away or stops answering pings.
+		gone := make(chan struct{})
+		conn.SetReadLimit(512)
+		_ = conn.SetReadDeadline(time.Now().Add(2 * heartbeatInterval))
+		conn.SetPongHandler(func(string) error {
+			return conn.SetReadDeadline(time.Now().Add(2 * heartbeatInterval))
+		})
+		go func() {
+			defer close(gone)
+			for {
+				if _, _, err := conn.NextReader(); err != nil {
+					return
+				}
+			}
+		}()
+
+		send := func(v any) error {
+			_ = conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+			return conn.WriteJSON(v)
+		}
+
+		if missed {
+			if err := send(gin.H{"type": resetEvent}); err != nil {
+				return
+			}
+		}
+
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+		accessCheck := time.NewTicker(accessCheckInterval)
+		defer accessCheck.Stop()
+
+		for {
+			select {
+			case <-gone:
+				return
+			case <-accessCheck.C:
+				if !mayStillRead(c, projects, projectID) {
+					_ = conn.WriteControl(
+						websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "access revoked"),
+						time.Now().Add(wsWriteTimeout),
+					)
+					return
+				}
+			case <-heartbeat.C:
+				if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(wsWriteTimeout)); err != nil {
+					return
+				}
+			case e, open := <-events:
+				if !open {
+					_ = conn.WriteControl(
+						websocket.CloseMessage,
+						websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "too far behind"),
+						time.Now().Add(wsWriteTimeout),
+					)
+					return
+				}
+				if err := send(dto.NewTaskEventResponse(e)); err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// parseEventStreamParams reads the project ID and the optional ID of the last
+// event the client received, from the Last-Event-ID header or the
+// last_event_id query parameter, and checks that the caller may read the
+// project.
+func parseEventStreamParams(c *gin.Context, projects ProjectService) (uuid.UUID, uuid.UUID, bool) {
+	projectID, err := uuid.Parse(c.Param("project_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	// Only those who may read the project may follow it.
+	if _, err := projects.GetByID(c, projectID); err != nil {
+		c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	var lastEventID uuid.UUID
+	raw := c.GetHeader("Last-Event-ID")
+	if raw == "" {
+		raw = c.Query("last_event_id")
+	}
+	if raw != "" {
+		if lastEventID, err = uuid.Parse(raw); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid last event ID"})
+			return uuid.Nil, uuid.Nil, false
+		}
+	}
+	return projectID, lastEventID, true
+}
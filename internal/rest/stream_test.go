@@ -0,0 +1,27 @@
+package rest
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowOrigins(t *testing.T) {
+	check := allowOrigins(splitOrigins("http://localhost:3000/, https://app.example.com"))
+
+	for origin, want := range map[string]bool{
+		"":                         true,
+		"http://api.example.com":   true,
+		"http://localhost:3000":    true,
+		"https://app.example.com":  true,
+		"https://evil.example.com": false,
+		"http://localhost:3001":    false,
+	} {
+		r := httptest.NewRequest("GET", "http://api.example.com/api/v1/projects/p/events/ws", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+		assert.Equal(t, want, check(r), origin)
+	}
+}
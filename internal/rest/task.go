@@ -8,6 +8,7 @@ import (
 
 	"task-manager/domain"
 	"task-manager/dto"
+	"task-manager/pkg/pagination"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -17,9 +18,20 @@ type TaskService interface {
 	Create(ctx context.Context, task *domain.Task) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
 	Update(ctx context.Context, task *domain.Task) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id, deletedBy uuid.UUID) error
+	Restore(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+	ListActivity(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Activity, string, error)
 	Assign(ctx context.Context, taskID uuid.UUID, userID uuid.UUID) error
-	Comment(ctx context.Context, taskID, userID uuid.UUID, content string) error
+	Comment(ctx context.Context, taskID, userID uuid.UUID, content string, parentID *uuid.UUID) (*domain.Comment, error)
+	ListComments(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Comment, string, error)
+	EditComment(ctx context.Context, taskID, commentID, userID uuid.UUID, content string) (*domain.Comment, error)
+	DeleteComment(ctx context.Context, taskID, commentID, userID uuid.UUID) error
+	ListSubtasks(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error)
+	AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+	RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error
+	ListDependencies(ctx context.Context, taskID uuid.UUID) (*domain.TaskDependencies, error)
+	AttachLabel(ctx context.Context, taskID, labelID uuid.UUID) error
+	DetachLabel(ctx context.Context, taskID, labelID uuid.UUID) error
 }
 
 // RegisterTaskRoutes registers task routes to the router group.
@@ -28,8 +40,21 @@ func RegisterTaskRoutes(rg *gin.RouterGroup, service TaskService) {
 	rg.GET("/:id", getTaskHandler(service))
 	rg.PUT("/:id", updateTaskHandler(service))
 	rg.DELETE("/:id", deleteTaskHandler(service))
+	rg.POST("/:id/restore", restoreTaskHandler(service))
+	rg.GET("/:id/activity", listActivityHandler(service))
 	rg.PUT("/:id/assign", assignTaskHandler(service))
 	rg.PUT("/:id/comment", commentOnTaskHandler(service))
+	rg.GET("/:id/comments", listCommentsHandler(service))
+	rg.POST("/:id/comments", createCommentHandler(service))
+	rg.PUT("/:id/comments/:comment_id", editCommentHandler(service))
+	rg.DELETE("/:id/comments/:comment_id", deleteCommentHandler(service))
+	rg.GET("/:id/subtasks", listSubtasksHandler(service))
+	rg.POST("/:id/subtasks", createSubtaskHandler(service))
+	rg.GET("/:id/dependencies", listDependenciesHandler(service))
+	rg.POST("/:id/dependencies", addDependencyHandler(service))
+	rg.DELETE("/:id/dependencies/:blocker_id", removeDependencyHandler(service))
+	rg.POST("/:id/labels", attachLabelHandler(service))
+	rg.DELETE("/:id/labels/:label_id", detachLabelHandler(service))
 }
 
 // createTaskHandler handles creating a new task
@@ -41,6 +66,8 @@ func RegisterTaskRoutes(rg *gin.RouterGroup, service TaskService) {
 //	@Param		request	body		dto.CreateTaskRequest	true	"Task data"
 //	@Success	200		{object}	dto.TaskResponse
 //	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	422		{object}	dto.ErrorResponse
 //	@Failure	500		{object}	dto.ErrorResponse
 //	@Router		/tasks [post]
 //	@Security	BearerAuth
@@ -64,17 +91,22 @@ func createTaskHandler(service TaskService) gin.HandlerFunc {
 			Title:       req.Title,
 			Description: req.Description,
 			Status:      req.Status,
+			Priority:    domain.Priority(req.Priority),
 			ProjectID:   req.ProjectID,
+			ParentID:    req.ParentID,
 			AssignedTo:  userID,
+			StartDate:   req.StartDate,
+			DueDate:     req.DueDate,
 			CreatedAt:   time.Now(),
 			UpdatedAt:   time.Now(),
 		}
 
 		if err := service.Create(c, t); err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
+		setETag(c, t.Version)
 		c.JSON(http.StatusOK, dto.NewTaskResponse(*t))
 	}
 }
@@ -86,7 +118,9 @@ func createTaskHandler(service TaskService) gin.HandlerFunc {
 //	@Produce	json
 //	@Param		id	path		string	true	"Task ID"
 //	@Success	200	{object}	dto.TaskResponse
+//	@Header		200	{string}	ETag	"Version of the task"
 //	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
 //	@Failure	404	{object}	dto.ErrorResponse
 //	@Router		/tasks/{id} [get]
 //	@Security	BearerAuth
@@ -101,25 +135,56 @@ func getTaskHandler(service TaskService) gin.HandlerFunc {
 
 		t, err := service.GetByID(c, id)
 		if err != nil {
-			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "task not found"})
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
+		setETag(c, t.Version)
 		c.JSON(http.StatusOK, dto.NewTaskResponse(*t))
 	}
 }
 
+// applyTaskUpdate copies the fields set in req onto task.
+func applyTaskUpdate(task *domain.Task, req dto.UpdateTaskRequest) {
+	if req.Title != nil {
+		task.Title = *req.Title
+	}
+	if req.Description != nil {
+		task.Description = *req.Description
+	}
+	if req.Status != nil {
+		task.Status = *req.Status
+	}
+	if req.Priority != nil {
+		task.Priority = domain.Priority(*req.Priority)
+	}
+	if req.ParentID != nil {
+		task.ParentID = req.ParentID
+	}
+	if req.StartDate != nil {
+		task.StartDate = req.StartDate
+	}
+	if req.DueDate != nil {
+		task.DueDate = req.DueDate
+	}
+}
+
 // updateTaskHandler updates a task
 //
 //	@Summary	Update a task by ID
 //	@Tags		Tasks
 //	@Accept		json
 //	@Produce	json
-//	@Param		id		path		string					true	"Task ID"
-//	@Param		request	body		dto.UpdateTaskRequest	true	"Updated task data"
-//	@Success	200		{object}	dto.TaskResponse
-//	@Failure	400		{object}	dto.ErrorResponse
-//	@Failure	500		{object}	dto.ErrorResponse
+//	@Param		id			path		string					true	"Task ID"
+//	@Param		If-Match	header		string					false	"ETag of the version being updated"
+//	@Param		request		body		dto.UpdateTaskRequest	true	"Updated task data"
+//	@Success	200			{object}	dto.TaskResponse
+//	@Header		200			{string}	ETag	"Version of the updated task"
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	412			{object}	dto.ErrorResponse
+//	@Failure	422			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
 //	@Router		/tasks/{id} [put]
 //	@Security	BearerAuth
 func updateTaskHandler(service TaskService) gin.HandlerFunc {
@@ -137,57 +202,97 @@ func updateTaskHandler(service TaskService) gin.HandlerFunc {
 			return
 		}
 
-		existing, err := service.GetByID(c, id)
+		expectedVersion, hasIfMatch, err := parseIfMatch(c)
 		if err != nil {
-			c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "task not found"})
+			c.JSON(http.StatusPreconditionFailed, dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
-		if req.Title != nil {
-			existing.Title = *req.Title
-		}
-		if req.Description != nil {
-			existing.Description = *req.Description
+		existing, err := service.GetByID(c, id)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
 		}
-		if req.Status != nil {
-			existing.Status = *req.Status
+		if hasIfMatch {
+			existing.Version = expectedVersion
 		}
 
+		applyTaskUpdate(existing, req)
+
 		if err := service.Update(c, existing); err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
+		setETag(c, existing.Version)
 		c.JSON(http.StatusOK, dto.NewTaskResponse(*existing))
 	}
 }
 
-// deleteTaskHandler deletes a task
+// deleteTaskHandler moves a task to the trash
 //
-//	@Summary	Delete a task by ID
+//	@Summary		Delete a task by ID
+//	@Description	Move a task and its comments to the project's trash
+//	@Tags			Tasks
+//	@Produce		json
+//	@Param			id	path		string	true	"Task ID"
+//	@Success		200	{object}	dto.SuccessResponse
+//	@Failure		400	{object}	dto.ErrorResponse
+//	@Failure		403	{object}	dto.ErrorResponse
+//	@Failure		409	{object}	dto.ErrorResponse
+//	@Failure		500	{object}	dto.ErrorResponse
+//	@Router			/tasks/{id} [delete]
+//	@Security		BearerAuth
+func deleteTaskHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		idStr := c.Param("id")
+		id, err := uuid.Parse(idStr)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, _ := uuid.Parse(userIDStr.(string))
+
+		if err := service.Delete(c, id, userID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "task deleted"})
+	}
+}
+
+// restoreTaskHandler takes a task out of the trash
+//
+//	@Summary	Restore a deleted task
 //	@Tags		Tasks
 //	@Produce	json
 //	@Param		id	path		string	true	"Task ID"
-//	@Success	200	{object}	dto.SuccessResponse
+//	@Success	200	{object}	dto.TaskResponse
 //	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
 //	@Failure	500	{object}	dto.ErrorResponse
-//	@Router		/tasks/{id} [delete]
+//	@Router		/tasks/{id}/restore [post]
 //	@Security	BearerAuth
-func deleteTaskHandler(service TaskService) gin.HandlerFunc {
+func restoreTaskHandler(service TaskService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		idStr := c.Param("id")
-		id, err := uuid.Parse(idStr)
+		id, err := uuid.Parse(c.Param("id"))
 		if err != nil {
 			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
 			return
 		}
 
-		if err := service.Delete(c, id); err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		task, err := service.Restore(c, id)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
-		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "task deleted"})
+		setETag(c, task.Version)
+		c.JSON(http.StatusOK, dto.NewTaskResponse(*task))
 	}
 }
 
@@ -201,6 +306,9 @@ func deleteTaskHandler(service TaskService) gin.HandlerFunc {
 //	@Param		request	body		dto.AssignRequest	true	"User to assign"
 //	@Success	200		{object}	dto.SuccessResponse
 //	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	412		{object}	dto.ErrorResponse
 //	@Failure	500		{object}	dto.ErrorResponse
 //	@Router		/tasks/{id}/assign [put]
 //	@Security	BearerAuth
@@ -220,7 +328,7 @@ func assignTaskHandler(service TaskService) gin.HandlerFunc {
 		}
 
 		if err := service.Assign(c, taskID, req.UserID); err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
@@ -228,36 +336,313 @@ func assignTaskHandler(service TaskService) gin.HandlerFunc {
 	}
 }
 
-// commentOnTaskHandler comments on task
+// listSubtasksHandler lists the subtasks of a task
+//
+//	@Summary	List subtasks of a task
+//	@Tags		Tasks
+//	@Produce	json
+//	@Param		id	path		string	true	"Parent task ID"
+//	@Success	200	{array}		dto.TaskResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/subtasks [get]
+//	@Security	BearerAuth
+func listSubtasksHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		parentID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		tasks, err := service.ListSubtasks(c, parentID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// createSubtaskHandler creates a subtask under a task
 //
-// @Summary		Comment on a task
-// @Tags		Tasks
-// @Accept		json
-// @Produce		json
-// @Param		id		path		string				true	"Task ID"
-// @Param		request	body		dto.CommentRequest	true	"Comment content"
-// @Success		200		{object}	dto.SuccessResponse
-// @Failure		400		{object}	dto.ErrorResponse
-// @Failure		500		{object}	dto.ErrorResponse
-// @Router		/tasks/{id}/comment [put]
-// @Security	BearerAuth
-func commentOnTaskHandler(service TaskService) gin.HandlerFunc {
+//	@Summary	Create a subtask
+//	@Tags		Tasks
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"Parent task ID"
+//	@Param		request	body		dto.CreateSubtaskRequest	true	"Subtask data"
+//	@Success	200		{object}	dto.TaskResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	422		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/subtasks [post]
+//	@Security	BearerAuth
+func createSubtaskHandler(service TaskService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		taskID, _ := uuid.Parse(c.Param("id"))
+		parentID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.CreateSubtaskRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
 		userIDStr, _ := c.Get(middleware.UserIDKey)
-		userID, _ := uuid.Parse(userIDStr.(string))
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		t := &domain.Task{
+			ID:          uuid.New(),
+			Title:       req.Title,
+			Description: req.Description,
+			Status:      req.Status,
+			Priority:    domain.Priority(req.Priority),
+			ParentID:    &parentID,
+			AssignedTo:  userID,
+			StartDate:   req.StartDate,
+			DueDate:     req.DueDate,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		}
+
+		if err := service.Create(c, t); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		setETag(c, t.Version)
+		c.JSON(http.StatusOK, dto.NewTaskResponse(*t))
+	}
+}
 
-		var req dto.CommentRequest
+// listDependenciesHandler lists the dependencies of a task
+//
+//	@Summary	List task dependencies
+//	@Tags		Tasks
+//	@Produce	json
+//	@Param		id	path		string	true	"Task ID"
+//	@Success	200	{object}	dto.DependenciesResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	403	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/dependencies [get]
+//	@Security	BearerAuth
+func listDependenciesHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		deps, err := service.ListDependencies(c, taskID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewDependenciesResponse(*deps))
+	}
+}
+
+// addDependencyHandler marks a task as blocked by another task
+//
+//	@Summary	Add a task dependency
+//	@Tags		Tasks
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"Blocked task ID"
+//	@Param		request	body		dto.AddDependencyRequest	true	"Blocking task"
+//	@Success	200		{object}	dto.SuccessResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	409		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/dependencies [post]
+//	@Security	BearerAuth
+func addDependencyHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.AddDependencyRequest
 		if err := c.ShouldBindJSON(&req); err != nil {
 			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
-		if err := service.Comment(c, taskID, userID, req.Content); err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+		if err := service.AddDependency(c, taskID, req.BlockerID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "dependency added"})
+	}
+}
+
+// removeDependencyHandler removes a dependency between two tasks
+//
+//	@Summary	Remove a task dependency
+//	@Tags		Tasks
+//	@Produce	json
+//	@Param		id			path		string	true	"Blocked task ID"
+//	@Param		blocker_id	path		string	true	"Blocking task ID"
+//	@Success	200			{object}	dto.SuccessResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/dependencies/{blocker_id} [delete]
+//	@Security	BearerAuth
+func removeDependencyHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		blockerID, err := uuid.Parse(c.Param("blocker_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid blocker ID"})
+			return
+		}
+
+		if err := service.RemoveDependency(c, taskID, blockerID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "dependency removed"})
+	}
+}
+
+// attachLabelHandler attaches a label to a task
+//
+//	@Summary	Attach a label to a task
+//	@Tags		Tasks
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string					true	"Task ID"
+//	@Param		request	body		dto.AttachLabelRequest	true	"Label to attach"
+//	@Success	200		{object}	dto.SuccessResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	403		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/labels [post]
+//	@Security	BearerAuth
+func attachLabelHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.AttachLabelRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		if err := service.AttachLabel(c, taskID, req.LabelID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "label attached"})
+	}
+}
+
+// detachLabelHandler removes a label from a task
+//
+//	@Summary	Detach a label from a task
+//	@Tags		Tasks
+//	@Produce	json
+//	@Param		id			path		string	true	"Task ID"
+//	@Param		label_id	path		string	true	"Label ID"
+//	@Success	200			{object}	dto.SuccessResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/tasks/{id}/labels/{label_id} [delete]
+//	@Security	BearerAuth
+func detachLabelHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		labelID, err := uuid.Parse(c.Param("label_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid label ID"})
+			return
+		}
+
+		if err := service.DetachLabel(c, taskID, labelID); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "label detached"})
+	}
+}
+
+// listActivityHandler lists the history of a task
+//
+//	@Summary		List task activity
+//	@Description	Get who changed what on a task, newest first
+//	@Tags			Tasks
+//	@Produce		json
+//	@Param			id		path		string	true	"Task ID"
+//	@Param			limit	query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"next_cursor of the previous page"
+//	@Success		200		{object}	dto.ActivityPageResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		404		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/tasks/{id}/activity [get]
+//	@Security		BearerAuth
+func listActivityHandler(service TaskService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskID, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		activities, next, err := service.ListActivity(c, taskID, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
-		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "comment added"})
+		c.JSON(http.StatusOK, dto.NewActivityPageResponse(activities, next))
 	}
 }
@@ -0,0 +1,50 @@
+package rest_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"task-manager/domain"
+	"task-manager/internal/rest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+// assignOnly is a TaskService that only implements Assign.
+type assignOnly struct {
+	rest.TaskService
+	err error
+}
+
+func (s assignOnly) Assign(context.Context, uuid.UUID, uuid.UUID) error { return s.err }
+
+func TestAssignTask_Errors(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	for name, tc := range map[string]struct {
+		err  error
+		want int
+	}{
+		"assigned":         {nil, http.StatusOK},
+		"version conflict": {fmt.Errorf("%w: task was modified", domain.ErrVersionConflict), http.StatusPreconditionFailed},
+		"forbidden":        {domain.ErrForbidden, http.StatusForbidden},
+		"not found":        {domain.ErrNotFound, http.StatusNotFound},
+	} {
+		r := gin.New()
+		rest.RegisterTaskRoutes(r.Group("/tasks"), assignOnly{err: tc.err})
+
+		body := fmt.Sprintf(`{"user_id":%q}`, uuid.New())
+		req := httptest.NewRequest(http.MethodPut, "/tasks/"+uuid.NewString()+"/assign", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+
+		assert.Equal(t, tc.want, w.Code, name)
+	}
+}
@@ -3,20 +3,37 @@ package rest
 import (
 	"context"
 	"net/http"
+	"time"
 
 	"task-manager/domain"
 	"task-manager/dto"
+	"task-manager/internal/rest/middleware"
+	"task-manager/pkg/pagination"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 )
 
 type UserService interface {
-	ListTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error)
+	ListTasks(
+		ctx context.Context,
+		userID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListOverdueTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error)
+	ListTasksDueThisWeek(ctx context.Context, userID uuid.UUID, loc *time.Location) ([]domain.Task, error)
+	ListTasksDueBetween(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.Task, error)
+	ListMentions(ctx context.Context, userID uuid.UUID, page pagination.Request) ([]domain.Mention, string, error)
 }
 
 func RegisterUserRoutes(rg *gin.RouterGroup, service UserService) {
 	rg.GET("/:user_id/tasks", ListTasksByUserHandler(service))
+	rg.GET("/:user_id/tasks/overdue", ListOverdueTasksByUserHandler(service))
+	rg.GET("/:user_id/tasks/due-this-week", ListTasksDueThisWeekByUserHandler(service))
+	rg.GET("/:user_id/tasks/due", ListTasksDueBetweenByUserHandler(service))
+	rg.GET("/me/mentions", ListMyMentionsHandler(service))
 }
 
 // ListTasksByUserHandler handles GET /users/:user_id/tasks
@@ -25,13 +42,73 @@ func RegisterUserRoutes(rg *gin.RouterGroup, service UserService) {
 //	@Description	Get all tasks assigned to a specific user
 //	@Tags			Users
 //	@Produce		json
+//	@Param			user_id			path		string	true	"User ID"
+//	@Param			label_ids		query		string	false	"Comma-separated label IDs"
+//	@Param			label_match		query		string	false	"Match any or all of label_ids"	Enums(any, all)	default(any)
+//	@Param			status			query		string	false	"Comma-separated statuses"
+//	@Param			q				query		string	false	"Text contained in the title or description"
+//	@Param			created_from	query		string	false	"Created at or after (RFC 3339)"
+//	@Param			created_to		query		string	false	"Created before (RFC 3339)"
+//	@Param			sort			query		string	false	"Sort field"													Enums(created_at, updated_at, due_date, priority)	default(created_at)
+//	@Param			order			query		string	false	"Sort order; defaults to asc for due_date and desc otherwise"	Enums(asc, desc)
+//	@Param			limit			query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor			query		string	false	"next_cursor of the previous page"
+//	@Success		200				{object}	dto.TaskPageResponse
+//	@Failure		400				{object}	dto.ErrorResponse
+//	@Failure		403				{object}	dto.ErrorResponse
+//	@Failure		500				{object}	dto.ErrorResponse
+//	@Router			/users/{user_id}/tasks [get]
+//	@Security		BearerAuth
+func ListTasksByUserHandler(service UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		filter, err := parseTaskFilter(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		sort, err := parseTaskSort(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, next, err := service.ListTasks(c, userID, filter, sort, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskPageResponse(tasks, next))
+	}
+}
+
+// ListOverdueTasksByUserHandler handles GET /users/:user_id/tasks/overdue
+//
+//	@Summary		List overdue tasks by user
+//	@Description	Get uncompleted tasks assigned to a user whose due date has passed
+//	@Tags			Users
+//	@Produce		json
 //	@Param			user_id	path		string	true	"User ID"
 //	@Success		200		{array}		dto.TaskResponse
 //	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
 //	@Failure		500		{object}	dto.ErrorResponse
-//	@Router			/users/{user_id}/tasks [get]
+//	@Router			/users/{user_id}/tasks/overdue [get]
 //	@Security		BearerAuth
-func ListTasksByUserHandler(service UserService) gin.HandlerFunc {
+func ListOverdueTasksByUserHandler(service UserService) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		userID, err := uuid.Parse(c.Param("user_id"))
 		if err != nil {
@@ -39,12 +116,128 @@ func ListTasksByUserHandler(service UserService) gin.HandlerFunc {
 			return
 		}
 
-		tasks, err := service.ListTasks(c, userID)
+		tasks, err := service.ListOverdueTasks(c, userID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: err.Error()})
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
 			return
 		}
 
 		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
 	}
 }
+
+// ListTasksDueThisWeekByUserHandler handles GET /users/:user_id/tasks/due-this-week
+//
+//	@Summary		List tasks due this week by user
+//	@Description	Get uncompleted tasks assigned to a user that are due in the current week (Monday to Sunday)
+//	@Tags			Users
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			tz		query		string	false	"IANA time zone the week is computed in"	default(UTC)
+//	@Success		200		{array}		dto.TaskResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/users/{user_id}/tasks/due-this-week [get]
+//	@Security		BearerAuth
+func ListTasksDueThisWeekByUserHandler(service UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		loc, err := parseLocation(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, err := service.ListTasksDueThisWeek(c, userID, loc)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// ListTasksDueBetweenByUserHandler handles GET /users/:user_id/tasks/due
+//
+//	@Summary		List tasks due in a time range by user
+//	@Description	Get uncompleted tasks assigned to a user that are due in [from, to)
+//	@Tags			Users
+//	@Produce		json
+//	@Param			user_id	path		string	true	"User ID"
+//	@Param			from	query		string	true	"Range start (RFC 3339)"
+//	@Param			to		query		string	true	"Range end, exclusive (RFC 3339)"
+//	@Success		200		{array}		dto.TaskResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/users/{user_id}/tasks/due [get]
+//	@Security		BearerAuth
+func ListTasksDueBetweenByUserHandler(service UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := uuid.Parse(c.Param("user_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		from, to, err := parseTimeRange(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		tasks, err := service.ListTasksDueBetween(c, userID, from, to)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewTaskResponseList(tasks))
+	}
+}
+
+// ListMyMentionsHandler handles GET /users/me/mentions
+//
+//	@Summary		List my mentions
+//	@Description	Get the tasks and comments where the caller was @mentioned, newest first
+//	@Tags			Users
+//	@Produce		json
+//	@Param			limit	query		int		false	"Page size (default 20, max 100)"
+//	@Param			cursor	query		string	false	"next_cursor of the previous page"
+//	@Success		200		{object}	dto.MentionPageResponse
+//	@Failure		400		{object}	dto.ErrorResponse
+//	@Failure		403		{object}	dto.ErrorResponse
+//	@Failure		500		{object}	dto.ErrorResponse
+//	@Router			/users/me/mentions [get]
+//	@Security		BearerAuth
+func ListMyMentionsHandler(service UserService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userIDStr, _ := c.Get(middleware.UserIDKey)
+		userID, err := uuid.Parse(userIDStr.(string))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid user ID"})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		mentions, next, err := service.ListMentions(c, userID, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewMentionPageResponse(mentions, next))
+	}
+}
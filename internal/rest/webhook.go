@@ -0,0 +1,338 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/dto"
+	"task-manager/pkg/pagination"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WebhookService interface {
+	Create(
+		ctx context.Context,
+		projectID uuid.UUID,
+		url, secret string,
+		events []domain.TaskEventType,
+	) (*domain.Webhook, error)
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error)
+	Update(
+		ctx context.Context,
+		id uuid.UUID,
+		url, secret *string,
+		events []domain.TaskEventType,
+		active *bool,
+	) (*domain.Webhook, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	ListDeliveries(
+		ctx context.Context,
+		webhookID uuid.UUID,
+		page pagination.Request,
+	) ([]domain.WebhookDelivery, string, error)
+	Delivery(ctx context.Context, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, []domain.WebhookAttempt, error)
+	Replay(ctx context.Context, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error)
+}
+
+// RegisterProjectWebhookRoutes registers the webhook routes nested under a project.
+func RegisterProjectWebhookRoutes(rg *gin.RouterGroup, service WebhookService) {
+	rg.GET("/:project_id/webhooks", listWebhooksHandler(service))
+	rg.POST("/:project_id/webhooks", createWebhookHandler(service))
+}
+
+// RegisterWebhookRoutes registers webhook routes to the router group.
+func RegisterWebhookRoutes(rg *gin.RouterGroup, service WebhookService) {
+	rg.GET("/:id", getWebhookHandler(service))
+	rg.PUT("/:id", updateWebhookHandler(service))
+	rg.DELETE("/:id", deleteWebhookHandler(service))
+	rg.GET("/:id/deliveries", listWebhookDeliveriesHandler(service))
+	rg.GET("/:id/deliveries/:delivery_id", getWebhookDeliveryHandler(service))
+	rg.POST("/:id/deliveries/:delivery_id/replay", replayWebhookDeliveryHandler(service))
+}
+
+// listWebhooksHandler lists the webhooks of a project
+//
+//	@Summary	List project webhooks
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		project_id	path		string	true	"Project ID"
+//	@Success	200			{array}		dto.WebhookResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	403			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/projects/{project_id}/webhooks [get]
+//	@Security	BearerAuth
+func listWebhooksHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		hooks, err := service.ListByProject(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWebhookResponseList(hooks))
+	}
+}
+
+// createWebhookHandler subscribes a URL to task events of a project
+//
+//	@Summary		Create a webhook
+//	@Description	Deliveries are POSTed as versioned JSON and signed in the X-Webhook-Signature header as "t=<unix time>,v1=<hex HMAC-SHA256 of "<t>.<body>">". The secret is only returned here.
+//	@Tags			Webhooks
+//	@Accept			json
+//	@Produce		json
+//	@Param			project_id	path		string						true	"Project ID"
+//	@Param			request		body		dto.CreateWebhookRequest	true	"Webhook to create"
+//	@Success		201			{object}	dto.WebhookResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/webhooks [post]
+//	@Security		BearerAuth
+func createWebhookHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		var req dto.CreateWebhookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		hook, err := service.Create(c, projectID, req.URL, req.Secret, req.EventTypes())
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		res := dto.NewWebhookResponse(*hook)
+		res.Secret = hook.Secret
+		c.JSON(http.StatusCreated, res)
+	}
+}
+
+// getWebhookHandler returns a webhook
+//
+//	@Summary	Get a webhook
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id	path		string	true	"Webhook ID"
+//	@Success	200	{object}	dto.WebhookResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/webhooks/{id} [get]
+//	@Security	BearerAuth
+func getWebhookHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		hook, err := service.GetByID(c, id)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWebhookResponse(*hook))
+	}
+}
+
+// updateWebhookHandler changes a webhook's URL, secret, events or active flag
+//
+//	@Summary	Update a webhook
+//	@Tags		Webhooks
+//	@Accept		json
+//	@Produce	json
+//	@Param		id		path		string						true	"Webhook ID"
+//	@Param		request	body		dto.UpdateWebhookRequest	true	"Webhook changes"
+//	@Success	200		{object}	dto.WebhookResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/webhooks/{id} [put]
+//	@Security	BearerAuth
+func updateWebhookHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		var req dto.UpdateWebhookRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		hook, err := service.Update(c, id, req.URL, req.Secret, req.EventTypes(), req.Active)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWebhookResponse(*hook))
+	}
+}
+
+// deleteWebhookHandler deletes a webhook along with its delivery log
+//
+//	@Summary	Delete a webhook
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id	path		string	true	"Webhook ID"
+//	@Success	200	{object}	dto.SuccessResponse
+//	@Failure	400	{object}	dto.ErrorResponse
+//	@Failure	404	{object}	dto.ErrorResponse
+//	@Failure	500	{object}	dto.ErrorResponse
+//	@Router		/webhooks/{id} [delete]
+//	@Security	BearerAuth
+func deleteWebhookHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		if err := service.Delete(c, id); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.SuccessResponse{Message: "Webhook deleted"})
+	}
+}
+
+// listWebhookDeliveriesHandler returns a webhook's delivery log
+//
+//	@Summary	List webhook deliveries
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id		path		string	true	"Webhook ID"
+//	@Param		limit	query		int		false	"Page size (default 20, max 100)"
+//	@Param		cursor	query		string	false	"next_cursor of the previous page"
+//	@Success	200		{object}	dto.WebhookDeliveryPageResponse
+//	@Failure	400		{object}	dto.ErrorResponse
+//	@Failure	404		{object}	dto.ErrorResponse
+//	@Failure	500		{object}	dto.ErrorResponse
+//	@Router		/webhooks/{id}/deliveries [get]
+//	@Security	BearerAuth
+func listWebhookDeliveriesHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, err := uuid.Parse(c.Param("id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+			return
+		}
+
+		page, err := parsePage(c)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		deliveries, next, err := service.ListDeliveries(c, id, page)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWebhookDeliveryPageResponse(deliveries, next))
+	}
+}
+
+// getWebhookDeliveryHandler returns a delivery with its payload and attempts
+//
+//	@Summary	Get a webhook delivery
+//	@Tags		Webhooks
+//	@Produce	json
+//	@Param		id			path		string	true	"Webhook ID"
+//	@Param		delivery_id	path		string	true	"Delivery ID"
+//	@Success	200			{object}	dto.WebhookDeliveryDetailResponse
+//	@Failure	400			{object}	dto.ErrorResponse
+//	@Failure	404			{object}	dto.ErrorResponse
+//	@Failure	500			{object}	dto.ErrorResponse
+//	@Router		/webhooks/{id}/deliveries/{delivery_id} [get]
+//	@Security	BearerAuth
+func getWebhookDeliveryHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, deliveryID, ok := parseDeliveryPath(c)
+		if !ok {
+			return
+		}
+
+		delivery, attempts, err := service.Delivery(c, id, deliveryID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWebhookDeliveryDetailResponse(*delivery, attempts))
+	}
+}
+
+// replayWebhookDeliveryHandler sends a delivery's payload again
+//
+//	@Summary		Replay a webhook delivery
+//	@Description	Queues a new delivery of the same payload, signed afresh when it is sent
+//	@Tags			Webhooks
+//	@Produce		json
+//	@Param			id			path		string	true	"Webhook ID"
+//	@Param			delivery_id	path		string	true	"Delivery ID"
+//	@Success		202			{object}	dto.WebhookDeliveryResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		404			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/webhooks/{id}/deliveries/{delivery_id}/replay [post]
+//	@Security		BearerAuth
+func replayWebhookDeliveryHandler(service WebhookService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id, deliveryID, ok := parseDeliveryPath(c)
+		if !ok {
+			return
+		}
+
+		replay, err := service.Replay(c, id, deliveryID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, dto.NewWebhookDeliveryResponse(*replay))
+	}
+}
+
+// parseDeliveryPath reads the webhook and delivery IDs of a delivery route,
+// responding with 400 when either is malformed.
+func parseDeliveryPath(c *gin.Context) (uuid.UUID, uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	deliveryID, err := uuid.Parse(c.Param("delivery_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid delivery ID"})
+		return uuid.Nil, uuid.Nil, false
+	}
+	return id, deliveryID, true
+}
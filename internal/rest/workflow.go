@@ -0,0 +1,93 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"task-manager/domain"
+	"task-manager/dto"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type WorkflowService interface {
+	Workflow(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error)
+	SetWorkflow(ctx context.Context, workflow *domain.Workflow) error
+}
+
+// RegisterWorkflowRoutes registers project workflow routes to the router group.
+func RegisterWorkflowRoutes(rg *gin.RouterGroup, service WorkflowService) {
+	rg.GET("/:project_id/workflow", getWorkflowHandler(service))
+	rg.PUT("/:project_id/workflow", setWorkflowHandler(service))
+}
+
+// getWorkflowHandler returns the workflow of a project
+//
+//	@Summary		Get project workflow
+//	@Description	Get the statuses and transitions tasks of a project follow
+//	@Tags			Projects
+//	@Produce		json
+//	@Param			project_id	path		string	true	"Project ID"
+//	@Success		200			{object}	dto.WorkflowResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/workflow [get]
+//	@Security		BearerAuth
+func getWorkflowHandler(service WorkflowService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		wf, err := service.Workflow(c, projectID)
+		if err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWorkflowResponse(*wf))
+	}
+}
+
+// setWorkflowHandler defines the workflow of a project
+//
+//	@Summary		Set project workflow
+//	@Description	Replace the statuses and transitions tasks of a project follow
+//	@Tags			Projects
+//	@Accept			json
+//	@Produce		json
+//	@Param			project_id	path		string				true	"Project ID"
+//	@Param			request		body		dto.WorkflowRequest	true	"Workflow definition"
+//	@Success		200			{object}	dto.WorkflowResponse
+//	@Failure		400			{object}	dto.ErrorResponse
+//	@Failure		403			{object}	dto.ErrorResponse
+//	@Failure		500			{object}	dto.ErrorResponse
+//	@Router			/projects/{project_id}/workflow [put]
+//	@Security		BearerAuth
+func setWorkflowHandler(service WorkflowService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID, err := uuid.Parse(c.Param("project_id"))
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "invalid project ID"})
+			return
+		}
+
+		var req dto.WorkflowRequest
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		wf := req.ToDomain(projectID)
+		if err := service.SetWorkflow(c, wf); err != nil {
+			c.JSON(statusForError(err), dto.ErrorResponse{Error: err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, dto.NewWorkflowResponse(*wf))
+	}
+}
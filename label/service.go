@@ -0,0 +1,116 @@
+package label
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"task-manager/authz"
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, label *domain.Label) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Label, error)
+	Update(ctx context.Context, label *domain.Label) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type Service struct {
+	repo       Repository
+	authorizer authz.Authorizer
+}
+
+func NewService(repo Repository, authorizer authz.Authorizer) *Service {
+	return &Service{repo: repo, authorizer: authorizer}
+}
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+func (s *Service) Create(ctx context.Context, projectID uuid.UUID, name, color string) (*domain.Label, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionManageProject); err != nil {
+		return nil, err
+	}
+	l := &domain.Label{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		Name:      strings.TrimSpace(name),
+		Color:     strings.ToLower(color),
+		CreatedAt: time.Now(),
+	}
+	if err := validate(l); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*domain.Label, error) {
+	return s.projectLabel(ctx, id, domain.PermissionRead)
+}
+
+func (s *Service) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Label, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByProject(ctx, projectID)
+}
+
+// Update renames and/or recolors a label; nil arguments are left unchanged.
+func (s *Service) Update(ctx context.Context, id uuid.UUID, name, color *string) (*domain.Label, error) {
+	l, err := s.projectLabel(ctx, id, domain.PermissionManageProject)
+	if err != nil {
+		return nil, err
+	}
+	if name != nil {
+		l.Name = strings.TrimSpace(*name)
+	}
+	if color != nil {
+		l.Color = strings.ToLower(*color)
+	}
+	if err := validate(l); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, l); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Delete removes a label and detaches it from every task.
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.projectLabel(ctx, id, domain.PermissionManageProject); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// projectLabel loads a label once the caller is known to hold the permission
+// in its project.
+func (s *Service) projectLabel(ctx context.Context, id uuid.UUID, permission domain.Permission) (*domain.Label, error) {
+	l, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Require(ctx, l.ProjectID, permission); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+func validate(l *domain.Label) error {
+	if l.Name == "" {
+		return fmt.Errorf("%w: name must not be empty", domain.ErrInvalidLabel)
+	}
+	if !colorPattern.MatchString(l.Color) {
+		return fmt.Errorf("%w: color must be a #rrggbb hex value", domain.ErrInvalidLabel)
+	}
+	return nil
+}
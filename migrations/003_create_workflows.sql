@@ -0,0 +1,34 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS workflow_statuses (
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    category TEXT NOT NULL CHECK (category IN ('todo', 'in_progress', 'done')),
+    position INT NOT NULL,
+    is_initial BOOLEAN NOT NULL DEFAULT false,
+    PRIMARY KEY (project_id, name)
+);
+
+-- Only one initial status per project
+CREATE UNIQUE INDEX IF NOT EXISTS idx_workflow_statuses_initial
+    ON workflow_statuses (project_id)
+    WHERE is_initial;
+
+CREATE TABLE IF NOT EXISTS workflow_transitions (
+    project_id UUID NOT NULL,
+    from_status TEXT NOT NULL,
+    to_status TEXT NOT NULL,
+    PRIMARY KEY (project_id, from_status, to_status),
+    FOREIGN KEY (project_id, from_status) REFERENCES workflow_statuses (project_id, name) ON DELETE CASCADE,
+    FOREIGN KEY (project_id, to_status) REFERENCES workflow_statuses (project_id, name) ON DELETE CASCADE
+);
+
+-- Normalize free-form statuses written before workflows were enforced
+UPDATE tasks SET status = lower(replace(trim(status), ' ', '_')) WHERE status IS NOT NULL;
+UPDATE tasks SET status = 'open' WHERE status IS NULL OR status = '';
+
+ALTER TABLE tasks ALTER COLUMN status SET NOT NULL;
+
+-- +goose Down
+ALTER TABLE tasks ALTER COLUMN status DROP NOT NULL;
+DROP TABLE IF EXISTS workflow_transitions;
+DROP TABLE IF EXISTS workflow_statuses;
@@ -0,0 +1,29 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS priority TEXT NOT NULL DEFAULT 'medium'
+        CHECK (priority IN ('low', 'medium', 'high', 'urgent')),
+    ADD COLUMN IF NOT EXISTS start_date TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS due_date TIMESTAMPTZ,
+    ADD COLUMN IF NOT EXISTS completed_at TIMESTAMPTZ;
+
+-- Tasks already sitting in a "done" status count as completed
+UPDATE tasks t
+SET completed_at = t.updated_at
+WHERE EXISTS (SELECT 1
+              FROM workflow_statuses ws
+              WHERE ws.project_id = t.project_id
+                AND ws.name = t.status
+                AND ws.category = 'done')
+   OR (t.status = 'done' AND NOT EXISTS (SELECT 1 FROM workflow_statuses ws WHERE ws.project_id = t.project_id));
+
+CREATE INDEX IF NOT EXISTS idx_tasks_assigned_to_due_date ON tasks (assigned_to, due_date) WHERE completed_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_project_id_due_date ON tasks (project_id, due_date) WHERE completed_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_project_id_due_date;
+DROP INDEX IF EXISTS idx_tasks_assigned_to_due_date;
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS completed_at,
+    DROP COLUMN IF EXISTS due_date,
+    DROP COLUMN IF EXISTS start_date,
+    DROP COLUMN IF EXISTS priority;
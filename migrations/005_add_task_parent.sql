@@ -0,0 +1,10 @@
+-- +goose Up
+-- Deleting a parent is refused while it still has subtasks
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES tasks (id) ON DELETE RESTRICT;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_parent_id ON tasks (parent_id) WHERE parent_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_parent_id;
+ALTER TABLE tasks DROP COLUMN IF EXISTS parent_id;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- blocker_id must be done before blocked_id can start
+CREATE TABLE IF NOT EXISTS task_dependencies (
+    blocker_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    blocked_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    PRIMARY KEY (blocker_id, blocked_id),
+    CHECK (blocker_id <> blocked_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_dependencies_blocked_id ON task_dependencies (blocked_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_dependencies;
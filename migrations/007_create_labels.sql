@@ -0,0 +1,23 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS labels (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    color TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now()
+);
+
+-- Label names are unique per project, ignoring case
+CREATE UNIQUE INDEX IF NOT EXISTS idx_labels_project_id_name ON labels (project_id, lower(name));
+
+CREATE TABLE IF NOT EXISTS task_labels (
+    task_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    label_id UUID NOT NULL REFERENCES labels (id) ON DELETE CASCADE,
+    PRIMARY KEY (task_id, label_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_labels_label_id ON task_labels (label_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_labels;
+DROP TABLE IF EXISTS labels;
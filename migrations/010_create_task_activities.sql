@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS task_activities (
+    id UUID PRIMARY KEY,
+    task_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    actor_id UUID NOT NULL,
+    action TEXT NOT NULL,
+    -- [{"field": ..., "from": ..., "to": ...}] for updates
+    changes JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_task_activities_task_id_created_at ON task_activities (task_id, created_at DESC, id DESC);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_activities;
@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Sortable priority, so that "urgent" ranks above "low"
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS priority_rank SMALLINT GENERATED ALWAYS AS (
+        CASE priority
+            WHEN 'low' THEN 0
+            WHEN 'medium' THEN 1
+            WHEN 'high' THEN 2
+            WHEN 'urgent' THEN 3
+        END) STORED;
+
+-- Keyset pagination walks (sort column, id) within a project or an assignee
+CREATE INDEX IF NOT EXISTS idx_tasks_project_created_at_id ON tasks (project_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_project_updated_at_id ON tasks (project_id, updated_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_project_due_date_id ON tasks (project_id, due_date, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_project_priority_rank_id ON tasks (project_id, priority_rank, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee_created_at_id ON tasks (assigned_to, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee_updated_at_id ON tasks (assigned_to, updated_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee_due_date_id ON tasks (assigned_to, due_date, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee_priority_rank_id ON tasks (assigned_to, priority_rank, id) WHERE deleted_at IS NULL;
+
+-- Status filter
+CREATE INDEX IF NOT EXISTS idx_tasks_project_status ON tasks (project_id, status) WHERE deleted_at IS NULL;
+
+-- Substring text search (ILIKE '%q%')
+CREATE INDEX IF NOT EXISTS idx_tasks_title_trgm ON tasks USING GIN (title gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_tasks_description_trgm ON tasks USING GIN (description gin_trgm_ops);
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_description_trgm;
+DROP INDEX IF EXISTS idx_tasks_title_trgm;
+DROP INDEX IF EXISTS idx_tasks_project_status;
+DROP INDEX IF EXISTS idx_tasks_assignee_priority_rank_id;
+DROP INDEX IF EXISTS idx_tasks_assignee_due_date_id;
+DROP INDEX IF EXISTS idx_tasks_assignee_updated_at_id;
+DROP INDEX IF EXISTS idx_tasks_assignee_created_at_id;
+DROP INDEX IF EXISTS idx_tasks_project_priority_rank_id;
+DROP INDEX IF EXISTS idx_tasks_project_due_date_id;
+DROP INDEX IF EXISTS idx_tasks_project_updated_at_id;
+DROP INDEX IF EXISTS idx_tasks_project_created_at_id;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS priority_rank;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Titles weigh more than descriptions when ranking tasks
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        setweight(to_tsvector('english', coalesce(title, '')), 'A') ||
+        setweight(to_tsvector('english', coalesce(description, '')), 'B')) STORED;
+
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS search_vector TSVECTOR GENERATED ALWAYS AS (
+        to_tsvector('english', coalesce(content, ''))) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_search_vector ON tasks USING GIN (search_vector) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_search_vector ON comments USING GIN (search_vector) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_comments_search_vector;
+DROP INDEX IF EXISTS idx_tasks_search_vector;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS search_vector;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS search_vector;
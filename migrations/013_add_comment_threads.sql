@@ -0,0 +1,17 @@
+-- +goose Up
+-- Replies outlive their parent once the trash purge removes it
+ALTER TABLE comments
+    ADD COLUMN IF NOT EXISTS parent_id UUID REFERENCES comments (id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS edited_at TIMESTAMPTZ;
+
+-- Comment pages walk (created_at, id) within a task
+CREATE INDEX IF NOT EXISTS idx_comments_task_created_at_id ON comments (task_id, created_at, id) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_comments_parent_id ON comments (parent_id) WHERE parent_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_comments_parent_id;
+DROP INDEX IF EXISTS idx_comments_task_created_at_id;
+
+ALTER TABLE comments
+    DROP COLUMN IF EXISTS edited_at,
+    DROP COLUMN IF EXISTS parent_id;
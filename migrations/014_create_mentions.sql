@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS mentions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    task_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    comment_id UUID REFERENCES comments (id) ON DELETE CASCADE,
+    author_id UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A user's mention feed, newest first
+CREATE INDEX IF NOT EXISTS idx_mentions_user_created_at_id ON mentions (user_id, created_at, id);
+
+-- +goose Down
+DROP TABLE IF EXISTS mentions;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS task_series (
+    id UUID PRIMARY KEY,
+    project_id UUID NOT NULL,
+    rrule TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    start TIMESTAMPTZ NOT NULL,
+    title TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    priority TEXT NOT NULL,
+    assigned_to UUID NOT NULL,
+    -- Seconds from the start of an occurrence to its due date
+    due_after BIGINT,
+    current_task_id UUID NOT NULL,
+    last_at TIMESTAMPTZ NOT NULL,
+    next_at TIMESTAMPTZ,
+    stopped_at TIMESTAMPTZ,
+    created_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The scheduler looks for active series by their next occurrence
+CREATE INDEX IF NOT EXISTS idx_task_series_next_at ON task_series (next_at) WHERE stopped_at IS NULL AND next_at IS NOT NULL;
+
+ALTER TABLE tasks
+    ADD COLUMN IF NOT EXISTS series_id UUID REFERENCES task_series (id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_tasks_series_id ON tasks (series_id) WHERE series_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_series_id;
+
+ALTER TABLE tasks
+    DROP COLUMN IF EXISTS series_id;
+
+DROP TABLE IF EXISTS task_series;
@@ -0,0 +1,77 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS notifications (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL,
+    event TEXT NOT NULL,
+    task_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    comment_id UUID REFERENCES comments (id) ON DELETE CASCADE,
+    actor_id UUID,
+    subject TEXT NOT NULL,
+    body TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS notification_deliveries (
+    id UUID PRIMARY KEY,
+    notification_id UUID NOT NULL REFERENCES notifications (id) ON DELETE CASCADE,
+    channel TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ NOT NULL,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    UNIQUE (notification_id, channel)
+);
+
+-- The dispatcher looks for pending deliveries by when they are due
+CREATE INDEX IF NOT EXISTS idx_notification_deliveries_due ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
+
+CREATE TABLE IF NOT EXISTS notification_attempts (
+    id UUID PRIMARY KEY,
+    delivery_id UUID NOT NULL REFERENCES notification_deliveries (id) ON DELETE CASCADE,
+    attempt INT NOT NULL,
+    succeeded BOOLEAN NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    attempted_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX IF NOT EXISTS idx_notification_attempts_delivery_id ON notification_attempts (delivery_id);
+
+CREATE TABLE IF NOT EXISTS inbox_items (
+    notification_id UUID PRIMARY KEY REFERENCES notifications (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    read_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- A user's inbox, newest first
+CREATE INDEX IF NOT EXISTS idx_inbox_items_user_created_at ON inbox_items (user_id, created_at, notification_id);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    user_id UUID PRIMARY KEY,
+    -- Channels per event, e.g. {"assigned": ["in_app", "email"]}
+    channels JSONB NOT NULL DEFAULT '{}',
+    email TEXT NOT NULL DEFAULT '',
+    webhook_url TEXT NOT NULL DEFAULT '',
+    quiet_hours_start TEXT NOT NULL DEFAULT '',
+    quiet_hours_end TEXT NOT NULL DEFAULT '',
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- Due-soon reminders that have been sent, one per task and due date
+CREATE TABLE IF NOT EXISTS task_reminders (
+    task_id UUID NOT NULL REFERENCES tasks (id) ON DELETE CASCADE,
+    due_date TIMESTAMPTZ NOT NULL,
+    sent_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (task_id, due_date)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS task_reminders;
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS inbox_items;
+DROP TABLE IF EXISTS notification_attempts;
+DROP TABLE IF EXISTS notification_deliveries;
+DROP TABLE IF EXISTS notifications;
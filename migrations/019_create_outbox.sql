@@ -0,0 +1,20 @@
+-- +goose Up
+-- Task events written in the transaction of the change they describe and
+-- removed once they have been relayed to every subscriber
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id UUID PRIMARY KEY,
+    sequence BIGSERIAL NOT NULL UNIQUE,
+    task_id UUID NOT NULL,
+    event_type TEXT NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    available_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- The relay takes the oldest event of each task, in order
+CREATE INDEX IF NOT EXISTS idx_outbox_events_task_id_sequence ON outbox_events (task_id, sequence);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
@@ -0,0 +1,63 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS projects (
+    id UUID PRIMARY KEY,
+    name TEXT NOT NULL,
+    -- Short code such as "WEB", stored in upper case
+    key TEXT NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    owner_id UUID NOT NULL,
+    archived BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE IF NOT EXISTS project_members (
+    project_id UUID NOT NULL REFERENCES projects (id) ON DELETE CASCADE,
+    user_id UUID NOT NULL,
+    role TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+-- A user's projects are looked up through their memberships
+CREATE INDEX IF NOT EXISTS idx_project_members_user_id ON project_members (user_id);
+
+-- Projects that tasks already refer to get a placeholder, owned by whoever
+-- created their first task, or by its assignee when that is not recorded.
+-- They are keyed P1, P2, ... in the order they were started, which is unique
+-- and fits the 10 characters a key may have.
+INSERT INTO projects (id, name, key, owner_id, created_at, updated_at)
+SELECT t.project_id,
+       'Project ' || left(t.project_id::text, 8),
+       'P' || row_number() OVER (ORDER BY t.created_at, t.project_id),
+       COALESCE(
+           (SELECT a.actor_id FROM task_activities a WHERE a.task_id = t.id AND a.action = 'created' LIMIT 1),
+           t.assigned_to
+       ),
+       t.created_at,
+       now()
+FROM (
+    SELECT DISTINCT ON (project_id) id, project_id, assigned_to, created_at
+    FROM tasks
+    ORDER BY project_id, created_at, id
+) t;
+
+INSERT INTO project_members (project_id, user_id, role, created_at)
+SELECT id, owner_id, 'owner', created_at
+FROM projects
+WHERE owner_id <> '00000000-0000-0000-0000-000000000000';
+
+-- Assignees keep seeing the projects they work in
+INSERT INTO project_members (project_id, user_id, role)
+SELECT DISTINCT project_id, assigned_to, 'member'
+FROM tasks
+WHERE assigned_to <> '00000000-0000-0000-0000-000000000000'
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE tasks
+    ADD CONSTRAINT fk_tasks_project_id FOREIGN KEY (project_id) REFERENCES projects (id);
+
+-- +goose Down
+ALTER TABLE tasks DROP CONSTRAINT IF EXISTS fk_tasks_project_id;
+DROP TABLE IF EXISTS project_members;
+DROP TABLE IF EXISTS projects;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- The task events relayed from the outbox, shared by every instance so that
+-- each can stream all of them to its clients. Only the most recent are kept.
+CREATE TABLE IF NOT EXISTS stream_events (
+    position BIGSERIAL PRIMARY KEY,
+    id UUID NOT NULL UNIQUE,
+    payload JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS stream_events;
@@ -0,0 +1,9 @@
+-- +goose Up
+-- Latest due date first still lists undated tasks last, which the ascending
+-- indexes of migration 011 cannot serve
+CREATE INDEX IF NOT EXISTS idx_tasks_project_due_date_desc_id ON tasks (project_id, due_date DESC NULLS LAST, id DESC) WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_tasks_assignee_due_date_desc_id ON tasks (assigned_to, due_date DESC NULLS LAST, id DESC) WHERE deleted_at IS NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS idx_tasks_assignee_due_date_desc_id;
+DROP INDEX IF EXISTS idx_tasks_project_due_date_desc_id;
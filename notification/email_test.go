@@ -0,0 +1,153 @@
+package notification_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/notification"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// smtpStandIn accepts one message the way a plain SMTP server does and hands
+// back the envelope and data it received.
+type smtpStandIn struct {
+	listener net.Listener
+	received chan receivedMail
+}
+
+type receivedMail struct {
+	from, to string
+	data     string
+}
+
+func newSMTPStandIn(t *testing.T) *smtpStandIn {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = l.Close() })
+
+	s := &smtpStandIn{listener: l, received: make(chan receivedMail, 1)}
+	go s.serve()
+	return s
+}
+
+func (s *smtpStandIn) port() int {
+	return s.listener.Addr().(*net.TCPAddr).Port
+}
+
+func (s *smtpStandIn) serve() {
+	conn, err := s.listener.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	var mail receivedMail
+	reply("220 localhost ESMTP")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		cmd := strings.TrimRight(line, "\r\n")
+		switch upper := strings.ToUpper(cmd); {
+		case strings.HasPrefix(upper, "EHLO"), strings.HasPrefix(upper, "HELO"):
+			reply("250 localhost")
+		case strings.HasPrefix(upper, "MAIL FROM:"):
+			mail.from = strings.Trim(cmd[len("MAIL FROM:"):], "<> ")
+			reply("250 OK")
+		case strings.HasPrefix(upper, "RCPT TO:"):
+			mail.to = strings.Trim(cmd[len("RCPT TO:"):], "<> ")
+			reply("250 OK")
+		case upper == "DATA":
+			reply("354 End data with <CR><LF>.<CR><LF>")
+			var data strings.Builder
+			for {
+				l, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if l == ".\r\n" {
+					break
+				}
+				data.WriteString(l)
+			}
+			mail.data = data.String()
+			reply("250 OK")
+		case upper == "QUIT":
+			reply("221 Bye")
+			s.received <- mail
+			return
+		default:
+			reply("502 Command not implemented")
+		}
+	}
+}
+
+func TestEmailChannel(t *testing.T) {
+	server := newSMTPStandIn(t)
+	ch := notification.NewEmailChannel(notification.SMTPConfig{
+		Host: "127.0.0.1",
+		Port: server.port(),
+		From: "Task Manager <tasks@example.com>",
+	})
+
+	n := domain.Notification{
+		ID:        uuid.New(),
+		UserID:    uuid.New(),
+		Event:     domain.EventAssigned,
+		TaskID:    uuid.New(),
+		Subject:   "You were assigned to \"Ship it\"",
+		Body:      "Ship it is yours now.",
+		CreatedAt: time.Now(),
+	}
+	prefs := domain.NotificationPreferences{UserID: n.UserID, Email: "alice@example.com"}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	require.NoError(t, ch.Send(ctx, n, prefs))
+
+	select {
+	case mail := <-server.received:
+		assert.Equal(t, "tasks@example.com", mail.from)
+		assert.Equal(t, "alice@example.com", mail.to)
+		assert.Contains(t, mail.data, "From: Task Manager <tasks@example.com>\r\n")
+		assert.Contains(t, mail.data, "To: alice@example.com\r\n")
+		assert.Contains(t, mail.data, "Subject: "+n.Subject+"\r\n")
+		assert.Contains(t, mail.data, "Message-ID: <"+n.ID.String()+"@127.0.0.1>")
+		assert.True(t, strings.HasSuffix(mail.data, "\r\n"+n.Body+"\r\n"))
+	case <-ctx.Done():
+		t.Fatal("no mail received")
+	}
+}
+
+func TestEmailChannelWithoutAddress(t *testing.T) {
+	ch := notification.NewEmailChannel(notification.SMTPConfig{Host: "127.0.0.1", Port: 1, From: "tasks@example.com"})
+
+	err := ch.Send(context.Background(), domain.Notification{ID: uuid.New()}, domain.NotificationPreferences{})
+	assert.ErrorIs(t, err, notification.ErrUndeliverable)
+}
+
+func TestEmailChannelServerDown(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := l.Addr().(*net.TCPAddr).Port
+	require.NoError(t, l.Close())
+
+	ch := notification.NewEmailChannel(notification.SMTPConfig{Host: "127.0.0.1", Port: port, From: "tasks@example.com"})
+	err = ch.Send(context.Background(), domain.Notification{ID: uuid.New()},
+		domain.NotificationPreferences{Email: "alice@example.com"})
+	require.Error(t, err)
+	// Connection failures are retried.
+	assert.NotErrorIs(t, err, notification.ErrUndeliverable)
+}
@@ -0,0 +1,108 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// HandleTaskEvent notifies the users a task event concerns: the new assignee
+// of a task, its assignee when its status changes, and whoever the change
+// mentions. Nobody is notified of their own changes.
+//
+// Events are relayed at least once, so the notifications of an event get IDs
+// derived from it and a redelivered event does not notify anyone twice.
+func (s *Service) HandleTaskEvent(ctx context.Context, e domain.TaskEvent) error {
+	var notifications []domain.Notification
+	switch e.Type {
+	case domain.TaskCreated, domain.TaskAssigned:
+		if n, ok := assignmentNotification(e); ok {
+			notifications = append(notifications, n)
+		}
+	case domain.TaskUpdated:
+		// A new assignee hears of the task through the TaskAssigned event.
+		if from, ok := changed(e.Changes, "status"); ok {
+			if _, reassigned := changed(e.Changes, "assigned_to"); !reassigned {
+				if n, ok := statusNotification(e, from); ok {
+					notifications = append(notifications, n)
+				}
+			}
+		}
+	}
+	notifications = append(notifications, mentionNotifications(e)...)
+
+	var errs []error
+	for _, n := range notifications {
+		n.ID = uuid.NewSHA1(e.ID, []byte(string(n.Event)+":"+n.UserID.String()))
+		n.TaskID = e.Task.ID
+		n.ActorID = e.ActorID
+		n.CreatedAt = e.OccurredAt
+		if err := s.Notify(ctx, n); err != nil {
+			errs = append(errs, fmt.Errorf("notify user %s of %s: %w", n.UserID, n.Event, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// assignmentNotification tells the assignee of the task that it is theirs
+// now. ok is false when there is nobody else to tell.
+func assignmentNotification(e domain.TaskEvent) (domain.Notification, bool) {
+	t := e.Task
+	if t.AssignedTo == uuid.Nil || t.AssignedTo == e.ActorID {
+		return domain.Notification{}, false
+	}
+	return domain.Notification{
+		UserID:  t.AssignedTo,
+		Event:   domain.EventAssigned,
+		Subject: fmt.Sprintf("You were assigned to %q", t.Title),
+		Body:    t.Description,
+	}, true
+}
+
+// statusNotification tells the assignee of the task that its status changed.
+// ok is false when there is nobody else to tell.
+func statusNotification(e domain.TaskEvent, from string) (domain.Notification, bool) {
+	t := e.Task
+	if t.AssignedTo == uuid.Nil || t.AssignedTo == e.ActorID {
+		return domain.Notification{}, false
+	}
+	return domain.Notification{
+		UserID:  t.AssignedTo,
+		Event:   domain.EventStatusChanged,
+		Subject: fmt.Sprintf("%q moved to %s", t.Title, t.Status),
+		Body:    fmt.Sprintf("%q moved from %s to %s.", t.Title, from, t.Status),
+	}, true
+}
+
+// mentionNotifications tells each user the change mentions about the mention.
+func mentionNotifications(e domain.TaskEvent) []domain.Notification {
+	notifications := make([]domain.Notification, 0, len(e.Mentions))
+	for _, m := range e.Mentions {
+		body := fmt.Sprintf("You were mentioned in the description of %q.", e.Task.Title)
+		if m.CommentID != nil {
+			body = fmt.Sprintf("You were mentioned in a comment on %q.", e.Task.Title)
+		}
+		notifications = append(notifications, domain.Notification{
+			UserID:    m.UserID,
+			Event:     domain.EventMentioned,
+			CommentID: m.CommentID,
+			Subject:   fmt.Sprintf("You were mentioned on %q", e.Task.Title),
+			Body:      body,
+		})
+	}
+	return notifications
+}
+
+// changed returns the previous value of field when changes include it.
+func changed(changes []domain.FieldChange, field string) (string, bool) {
+	for _, c := range changes {
+		if c.Field == field {
+			return c.From, true
+		}
+	}
+	return "", false
+}
@@ -0,0 +1,127 @@
+package notification_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/notification"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryNotifications keeps created notifications by ID, ignoring repeats
+// the way the postgres repository does.
+type memoryNotifications struct {
+	notification.Repository
+	stored map[uuid.UUID]domain.Notification
+}
+
+func (m *memoryNotifications) Create(_ context.Context, n *domain.Notification, _ []domain.Delivery) error {
+	if _, ok := m.stored[n.ID]; !ok {
+		m.stored[n.ID] = *n
+	}
+	return nil
+}
+
+// noPreferences leaves every user on the default preferences.
+type noPreferences struct {
+	notification.PreferenceRepository
+}
+
+func (noPreferences) Get(context.Context, uuid.UUID) (*domain.NotificationPreferences, error) {
+	return nil, domain.ErrNotFound
+}
+
+func newEventTestService() (*notification.Service, *memoryNotifications) {
+	repo := &memoryNotifications{stored: make(map[uuid.UUID]domain.Notification)}
+	channels := []notification.Channel{notification.NewInAppChannel(nil)}
+	return notification.NewService(repo, noPreferences{}, nil, nil, nil, channels, notification.DefaultConfig), repo
+}
+
+func TestService_HandleTaskEvent_StatusChange(t *testing.T) {
+	svc, repo := newEventTestService()
+
+	actorID := uuid.New()
+	assigneeID := uuid.New()
+	event := domain.TaskEvent{
+		ID:         uuid.New(),
+		Type:       domain.TaskUpdated,
+		ActorID:    actorID,
+		OccurredAt: time.Now(),
+		Task:       domain.Task{ID: uuid.New(), Title: "Ship it", Status: "in_progress", AssignedTo: assigneeID},
+		Changes:    []domain.FieldChange{{Field: "status", From: "open", To: "in_progress"}},
+	}
+
+	require.NoError(t, svc.HandleTaskEvent(context.Background(), event))
+	// A redelivered event does not notify twice.
+	require.NoError(t, svc.HandleTaskEvent(context.Background(), event))
+
+	require.Len(t, repo.stored, 1)
+	for _, n := range repo.stored {
+		assert.Equal(t, assigneeID, n.UserID)
+		assert.Equal(t, domain.EventStatusChanged, n.Event)
+		assert.Equal(t, event.Task.ID, n.TaskID)
+		assert.Equal(t, actorID, n.ActorID)
+		assert.Equal(t, `"Ship it" moved from open to in_progress.`, n.Body)
+	}
+}
+
+func TestService_HandleTaskEvent_Skips(t *testing.T) {
+	assigneeID := uuid.New()
+	tests := []struct {
+		name  string
+		event domain.TaskEvent
+	}{
+		{
+			name: "own change",
+			event: domain.TaskEvent{
+				Type:    domain.TaskUpdated,
+				ActorID: assigneeID,
+				Task:    domain.Task{ID: uuid.New(), AssignedTo: assigneeID},
+				Changes: []domain.FieldChange{{Field: "status", From: "open", To: "done"}},
+			},
+		},
+		{
+			// The new assignee hears of it through the TaskAssigned event.
+			name: "status change with a new assignee",
+			event: domain.TaskEvent{
+				Type: domain.TaskUpdated,
+				Task: domain.Task{ID: uuid.New(), AssignedTo: assigneeID},
+				Changes: []domain.FieldChange{
+					{Field: "status", From: "open", To: "done"},
+					{Field: "assigned_to", From: uuid.Nil.String(), To: assigneeID.String()},
+				},
+			},
+		},
+		{
+			name:  "unassigned task",
+			event: domain.TaskEvent{Type: domain.TaskCreated, Task: domain.Task{ID: uuid.New()}},
+		},
+		{
+			name:  "deletion",
+			event: domain.TaskEvent{Type: domain.TaskDeleted, Task: domain.Task{ID: uuid.New(), AssignedTo: assigneeID}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, repo := newEventTestService()
+			tt.event.ID = uuid.New()
+
+			require.NoError(t, svc.HandleTaskEvent(context.Background(), tt.event))
+			assert.Empty(t, repo.stored)
+		})
+	}
+}
+
+func TestService_SetPreferences_RejectsInternalWebhookURL(t *testing.T) {
+	svc, _ := newEventTestService()
+
+	for _, raw := range []string{"http://169.254.169.254/latest/meta-data", "http://10.0.0.5/hook", "http://localhost:9000"} {
+		err := svc.SetPreferences(context.Background(), &domain.NotificationPreferences{UserID: uuid.New(), WebhookURL: raw})
+		assert.ErrorIs(t, err, domain.ErrInvalidPreferences, raw)
+	}
+}
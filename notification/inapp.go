@@ -0,0 +1,25 @@
+package notification
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+)
+
+// InAppChannel puts notifications in the recipient's inbox.
+type InAppChannel struct {
+	inbox InboxRepository
+}
+
+func NewInAppChannel(inbox InboxRepository) *InAppChannel {
+	return &InAppChannel{inbox: inbox}
+}
+
+func (c *InAppChannel) Name() domain.NotificationChannel {
+	return domain.ChannelInApp
+}
+
+func (c *InAppChannel) Send(ctx context.Context, n domain.Notification, _ domain.NotificationPreferences) error {
+	return c.inbox.Add(ctx, n.UserID, n.ID, time.Now())
+}
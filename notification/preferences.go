@@ -0,0 +1,134 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/safehttp"
+
+	"github.com/google/uuid"
+)
+
+const clockLayout = "15:04"
+
+// Preferences returns a user's notification preferences, or the defaults
+// when they have not set any.
+func (s *Service) Preferences(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error) {
+	prefs, err := s.preferences.Get(ctx, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return &domain.NotificationPreferences{UserID: userID, Timezone: "UTC"}, nil
+	}
+	return prefs, err
+}
+
+// SetPreferences validates and replaces a user's notification preferences.
+func (s *Service) SetPreferences(ctx context.Context, prefs *domain.NotificationPreferences) error {
+	if err := normalizePreferences(prefs, s.cfg.WebhookTargets); err != nil {
+		return err
+	}
+	prefs.UpdatedAt = time.Now()
+	return s.preferences.Save(ctx, prefs)
+}
+
+func normalizePreferences(prefs *domain.NotificationPreferences, webhookTargets safehttp.Policy) error {
+	for event, channels := range prefs.Channels {
+		if !event.Valid() {
+			return fmt.Errorf("%w: unknown event %q", domain.ErrInvalidPreferences, event)
+		}
+		seen := make(map[domain.NotificationChannel]bool, len(channels))
+		unique := make([]domain.NotificationChannel, 0, len(channels))
+		for _, ch := range channels {
+			if !ch.Valid() {
+				return fmt.Errorf("%w: unknown channel %q", domain.ErrInvalidPreferences, ch)
+			}
+			if !seen[ch] {
+				seen[ch] = true
+				unique = append(unique, ch)
+			}
+		}
+		prefs.Channels[event] = unique
+	}
+
+	prefs.Email = strings.TrimSpace(prefs.Email)
+	if prefs.Email != "" {
+		addr, err := mail.ParseAddress(prefs.Email)
+		if err != nil {
+			return fmt.Errorf("%w: invalid email: %v", domain.ErrInvalidPreferences, err)
+		}
+		prefs.Email = addr.Address
+	}
+
+	prefs.WebhookURL = strings.TrimSpace(prefs.WebhookURL)
+	if prefs.WebhookURL != "" {
+		if err := webhookTargets.CheckURL(prefs.WebhookURL); err != nil {
+			return fmt.Errorf("%w: webhook URL %v", domain.ErrInvalidPreferences, err)
+		}
+	}
+
+	if (prefs.QuietHoursStart == "") != (prefs.QuietHoursEnd == "") {
+		return fmt.Errorf("%w: quiet hours need both a start and an end", domain.ErrInvalidPreferences)
+	}
+	for _, clock := range []string{prefs.QuietHoursStart, prefs.QuietHoursEnd} {
+		if clock == "" {
+			continue
+		}
+		if _, err := time.Parse(clockLayout, clock); err != nil {
+			return fmt.Errorf("%w: quiet hours must be HH:MM, got %q", domain.ErrInvalidPreferences, clock)
+		}
+	}
+
+	prefs.Timezone = strings.TrimSpace(prefs.Timezone)
+	if prefs.Timezone == "" {
+		prefs.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(prefs.Timezone); err != nil {
+		return fmt.Errorf("%w: unknown timezone %q", domain.ErrInvalidPreferences, prefs.Timezone)
+	}
+	return nil
+}
+
+// afterQuietHours returns t, or the end of the recipient's quiet hours when
+// t falls within them. Quiet hours may span midnight, e.g. 22:00 to 07:00.
+func afterQuietHours(prefs domain.NotificationPreferences, t time.Time) time.Time {
+	if prefs.QuietHoursStart == "" || prefs.QuietHoursEnd == "" {
+		return t
+	}
+	start, err := time.Parse(clockLayout, prefs.QuietHoursStart)
+	if err != nil {
+		return t
+	}
+	end, err := time.Parse(clockLayout, prefs.QuietHoursEnd)
+	if err != nil {
+		return t
+	}
+	loc, err := time.LoadLocation(prefs.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := t.In(loc)
+	at := func(day time.Time, clock time.Time) time.Time {
+		return time.Date(day.Year(), day.Month(), day.Day(), clock.Hour(), clock.Minute(), 0, 0, loc)
+	}
+	quietStart, quietEnd := at(local, start), at(local, end)
+
+	switch {
+	case !quietStart.After(quietEnd):
+		// Same-day window, e.g. 12:00 to 14:00.
+		if !local.Before(quietStart) && local.Before(quietEnd) {
+			return quietEnd
+		}
+	case !local.Before(quietStart):
+		// Overnight window, late part: ends tomorrow.
+		return at(local.AddDate(0, 0, 1), end)
+	case local.Before(quietEnd):
+		// Overnight window, early part: ends today.
+		return quietEnd
+	}
+	return t
+}
@@ -0,0 +1,356 @@
+// Package notification delivers notifications to users over the channels
+// they choose.
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	"task-manager/pkg/safehttp"
+
+	"github.com/google/uuid"
+)
+
+// Notifier accepts notifications for delivery.
+type Notifier interface {
+	Notify(ctx context.Context, n domain.Notification) error
+}
+
+// Channel delivers a notification over one medium.
+type Channel interface {
+	Name() domain.NotificationChannel
+	// Send delivers n according to the recipient's preferences. Errors
+	// wrapping ErrUndeliverable are not retried.
+	Send(ctx context.Context, n domain.Notification, prefs domain.NotificationPreferences) error
+}
+
+// ErrUndeliverable marks delivery failures that retrying cannot fix, such as
+// a missing address.
+var ErrUndeliverable = errors.New("undeliverable")
+
+type Repository interface {
+	// Create stores a notification along with its pending deliveries. It is
+	// a no-op when a notification with the same ID is already stored.
+	Create(ctx context.Context, n *domain.Notification, deliveries []domain.Delivery) error
+	// ClaimDue leases up to limit pending deliveries that are due, so that
+	// concurrent dispatchers do not send them twice, and loads their
+	// notifications.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.Delivery, error)
+	// RecordAttempt saves the outcome of a delivery attempt and logs the attempt.
+	RecordAttempt(ctx context.Context, delivery *domain.Delivery, attempt *domain.DeliveryAttempt) error
+}
+
+// PreferenceRepository stores notification preferences. Get returns
+// domain.ErrNotFound for users who have not set any.
+type PreferenceRepository interface {
+	Get(ctx context.Context, userID uuid.UUID) (*domain.NotificationPreferences, error)
+	Save(ctx context.Context, prefs *domain.NotificationPreferences) error
+}
+
+// InboxRepository stores the in-app inbox.
+type InboxRepository interface {
+	Add(ctx context.Context, userID, notificationID uuid.UUID, at time.Time) error
+	List(ctx context.Context, userID uuid.UUID, unreadOnly bool, page pagination.Request) ([]domain.Notification, string, error)
+	// MarkRead marks the given notifications read, or all of them when ids is empty.
+	MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID, at time.Time) error
+}
+
+// ReminderRepository finds tasks that are due soon and remembers which due
+// dates have been reminded about.
+type ReminderRepository interface {
+	// ListDueSoon returns assigned, uncompleted tasks due in (now, until]
+	// that have not been reminded about for their current due date.
+	ListDueSoon(ctx context.Context, now, until time.Time) ([]domain.Task, error)
+	// MarkReminded records the reminder, returning false when it was already recorded.
+	MarkReminded(ctx context.Context, taskID uuid.UUID, dueDate, at time.Time) (bool, error)
+}
+
+// Transactor runs fn in a database transaction that repositories called with
+// the ctx passed to fn take part in.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+// Config tunes delivery retries.
+type Config struct {
+	// MaxAttempts is how often a delivery is tried before it is marked failed.
+	MaxAttempts int
+	// RetryBackoff is the wait after the first failed attempt. It doubles
+	// with every further failure, up to MaxBackoff.
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long a claimed delivery is held before another dispatcher
+	// may try it again.
+	Lease     time.Duration
+	BatchSize int
+	// WebhookTargets decides which addresses the webhook URLs of preferences
+	// may point at.
+	WebhookTargets safehttp.Policy
+}
+
+var DefaultConfig = Config{
+	MaxAttempts:  5,
+	RetryBackoff: 30 * time.Second,
+	MaxBackoff:   time.Hour,
+	Lease:        5 * time.Minute,
+	BatchSize:    50,
+}
+
+// ConfigFromEnv reads NOTIFICATION_MAX_ATTEMPTS, NOTIFICATION_RETRY_BACKOFF
+// and WEBHOOK_ALLOW_PRIVATE_NETWORKS, falling back to DefaultConfig.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+	targets, err := safehttp.PolicyFromEnv()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.WebhookTargets = targets
+	if raw := os.Getenv("NOTIFICATION_MAX_ATTEMPTS"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return Config{}, fmt.Errorf("invalid NOTIFICATION_MAX_ATTEMPTS: %q", raw)
+		}
+		cfg.MaxAttempts = n
+	}
+	if raw := os.Getenv("NOTIFICATION_RETRY_BACKOFF"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid NOTIFICATION_RETRY_BACKOFF: %q", raw)
+		}
+		cfg.RetryBackoff = d
+	}
+	return cfg, nil
+}
+
+type Service struct {
+	repo        Repository
+	preferences PreferenceRepository
+	inbox       InboxRepository
+	reminders   ReminderRepository
+	tx          Transactor
+	channels    map[domain.NotificationChannel]Channel
+	cfg         Config
+	wake        chan struct{}
+}
+
+func NewService(
+	repo Repository,
+	preferences PreferenceRepository,
+	inbox InboxRepository,
+	reminders ReminderRepository,
+	tx Transactor,
+	channels []Channel,
+	cfg Config,
+) *Service {
+	byName := make(map[domain.NotificationChannel]Channel, len(channels))
+	for _, ch := range channels {
+		byName[ch.Name()] = ch
+	}
+	return &Service{
+		repo:        repo,
+		preferences: preferences,
+		inbox:       inbox,
+		reminders:   reminders,
+		tx:          tx,
+		channels:    byName,
+		cfg:         cfg,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Notify queues n for delivery on the channels the recipient chose for its
+// event. Delivery happens in the background; see DeliverDue.
+func (s *Service) Notify(ctx context.Context, n domain.Notification) error {
+	if n.UserID == uuid.Nil {
+		return nil
+	}
+	prefs, err := s.Preferences(ctx, n.UserID)
+	if err != nil {
+		return err
+	}
+
+	if n.ID == uuid.Nil {
+		n.ID = uuid.New()
+	}
+	if n.CreatedAt.IsZero() {
+		n.CreatedAt = time.Now()
+	}
+
+	var deliveries []domain.Delivery
+	for _, name := range prefs.ChannelsFor(n.Event) {
+		if _, ok := s.channels[name]; !ok {
+			log.Printf("notification %s: channel %s is not configured", n.ID, name)
+			continue
+		}
+		next := n.CreatedAt
+		if name != domain.ChannelInApp {
+			next = afterQuietHours(*prefs, next)
+		}
+		deliveries = append(deliveries, domain.Delivery{
+			ID:             uuid.NewSHA1(n.ID, []byte(name)),
+			NotificationID: n.ID,
+			Channel:        name,
+			Status:         domain.DeliveryPending,
+			NextAttemptAt:  next,
+			CreatedAt:      n.CreatedAt,
+			UpdatedAt:      n.CreatedAt,
+		})
+	}
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	if err := s.repo.Create(ctx, &n, deliveries); err != nil {
+		return err
+	}
+
+	// Let the dispatcher pick the deliveries up without waiting for its next tick.
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Wake signals when new deliveries have been queued.
+func (s *Service) Wake() <-chan struct{} {
+	return s.wake
+}
+
+// DeliverDue attempts the pending deliveries that are due and returns how
+// many were sent. Every attempt is logged. Failed attempts are retried with
+// exponential backoff until Config.MaxAttempts is reached.
+func (s *Service) DeliverDue(ctx context.Context, now time.Time) (int, error) {
+	deliveries, err := s.repo.ClaimDue(ctx, now, s.cfg.Lease, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	prefsByUser := make(map[uuid.UUID]*domain.NotificationPreferences)
+	for i := range deliveries {
+		d := &deliveries[i]
+
+		prefs, ok := prefsByUser[d.Notification.UserID]
+		if !ok {
+			if prefs, err = s.Preferences(ctx, d.Notification.UserID); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			prefsByUser[d.Notification.UserID] = prefs
+		}
+
+		sendErr := s.send(ctx, d, *prefs)
+		d.Attempts++
+		attempt := &domain.DeliveryAttempt{
+			ID:          uuid.New(),
+			DeliveryID:  d.ID,
+			Attempt:     d.Attempts,
+			Succeeded:   sendErr == nil,
+			AttemptedAt: time.Now(),
+		}
+
+		switch {
+		case sendErr == nil:
+			d.Status = domain.DeliverySent
+			d.LastError = ""
+			sent++
+			log.Printf("notification %s via %s: attempt %d sent", d.NotificationID, d.Channel, d.Attempts)
+		case errors.Is(sendErr, ErrUndeliverable) || d.Attempts >= s.cfg.MaxAttempts:
+			attempt.Error = sendErr.Error()
+			d.Status = domain.DeliveryFailed
+			d.LastError = sendErr.Error()
+			log.Printf("notification %s via %s: attempt %d failed, giving up: %v",
+				d.NotificationID, d.Channel, d.Attempts, sendErr)
+		default:
+			attempt.Error = sendErr.Error()
+			d.LastError = sendErr.Error()
+			d.NextAttemptAt = afterQuietHours(*prefs, now.Add(s.backoff(d.Attempts)))
+			log.Printf("notification %s via %s: attempt %d failed, retrying at %s: %v",
+				d.NotificationID, d.Channel, d.Attempts, d.NextAttemptAt.Format(time.RFC3339), sendErr)
+		}
+
+		if err := s.repo.RecordAttempt(ctx, d, attempt); err != nil {
+			errs = append(errs, fmt.Errorf("record attempt of delivery %s: %w", d.ID, err))
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+func (s *Service) send(ctx context.Context, d *domain.Delivery, prefs domain.NotificationPreferences) error {
+	ch, ok := s.channels[d.Channel]
+	if !ok {
+		return fmt.Errorf("%w: channel %s is not configured", ErrUndeliverable, d.Channel)
+	}
+	return ch.Send(ctx, *d.Notification, prefs)
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (s *Service) backoff(attempts int) time.Duration {
+	wait := s.cfg.RetryBackoff
+	for i := 1; i < attempts && wait < s.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, s.cfg.MaxBackoff)
+}
+
+// RemindDueSoon notifies assignees of tasks due within window, once per due
+// date, and returns how many reminders were sent.
+func (s *Service) RemindDueSoon(ctx context.Context, now time.Time, window time.Duration) (int, error) {
+	tasks, err := s.reminders.ListDueSoon(ctx, now, now.Add(window))
+	if err != nil {
+		return 0, err
+	}
+
+	reminded := 0
+	var errs []error
+	for _, t := range tasks {
+		first := false
+		err := s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+			var err error
+			first, err = s.reminders.MarkReminded(ctx, t.ID, *t.DueDate, now)
+			if err != nil || !first {
+				return err
+			}
+			return s.Notify(ctx, domain.Notification{
+				UserID:  t.AssignedTo,
+				Event:   domain.EventDueSoon,
+				TaskID:  t.ID,
+				Subject: fmt.Sprintf("%q is due soon", t.Title),
+				Body:    fmt.Sprintf("%q is due at %s.", t.Title, t.DueDate.UTC().Format(time.RFC1123)),
+			})
+		})
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("remind task %s: %w", t.ID, err))
+		case first:
+			reminded++
+		}
+	}
+	return reminded, errors.Join(errs...)
+}
+
+// ListInbox returns one page of a user's in-app notifications, newest first,
+// and the cursor of the next page (empty on the last page).
+func (s *Service) ListInbox(
+	ctx context.Context,
+	userID uuid.UUID,
+	unreadOnly bool,
+	page pagination.Request,
+) ([]domain.Notification, string, error) {
+	return s.inbox.List(ctx, userID, unreadOnly, page)
+}
+
+// MarkRead marks notifications in a user's inbox read, or all of them when
+// ids is empty.
+func (s *Service) MarkRead(ctx context.Context, userID uuid.UUID, ids []uuid.UUID) error {
+	return s.inbox.MarkRead(ctx, userID, ids, time.Now())
+}
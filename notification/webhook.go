@@ -0,0 +1,87 @@
+package notification
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// WebhookChannel POSTs notifications as JSON to the URL the recipient set in
+// their preferences. Responses other than 2xx, redirects included, are
+// failures.
+type WebhookChannel struct {
+	client *http.Client
+}
+
+func NewWebhookChannel(cfg Config) *WebhookChannel {
+	return &WebhookChannel{client: cfg.WebhookTargets.NewClient(10 * time.Second)}
+}
+
+func (c *WebhookChannel) Name() domain.NotificationChannel {
+	return domain.ChannelWebhook
+}
+
+type webhookPayload struct {
+	ID        string    `json:"id"`
+	UserID    string    `json:"user_id"`
+	Event     string    `json:"event"`
+	TaskID    string    `json:"task_id"`
+	CommentID *string   `json:"comment_id,omitempty"`
+	ActorID   string    `json:"actor_id,omitempty"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func (c *WebhookChannel) Send(ctx context.Context, n domain.Notification, prefs domain.NotificationPreferences) error {
+	if prefs.WebhookURL == "" {
+		return fmt.Errorf("%w: no webhook URL set", ErrUndeliverable)
+	}
+
+	payload := webhookPayload{
+		ID:        n.ID.String(),
+		UserID:    n.UserID.String(),
+		Event:     string(n.Event),
+		TaskID:    n.TaskID.String(),
+		Subject:   n.Subject,
+		Body:      n.Body,
+		CreatedAt: n.CreatedAt,
+	}
+	if n.CommentID != nil {
+		id := n.CommentID.String()
+		payload.CommentID = &id
+	}
+	if n.ActorID != uuid.Nil {
+		payload.ActorID = n.ActorID.String()
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, prefs.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUndeliverable, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Package outbox relays the task events stored in the transactional outbox
+// to the subscribers that react to them.
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"task-manager/domain"
+)
+
+type Repository interface {
+	// ClaimDue leases up to limit events that are due and are the oldest
+	// unrelayed event of their task, so that the events of a task are relayed
+	// in order and concurrent relays do not relay them twice.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.OutboxEvent, error)
+	// MarkRelayed removes a relayed event from the outbox.
+	MarkRelayed(ctx context.Context, event *domain.OutboxEvent) error
+	// Reschedule saves a failed attempt so that the event is retried at
+	// event.AvailableAt.
+	Reschedule(ctx context.Context, event *domain.OutboxEvent) error
+}
+
+// Subscriber reacts to task events. Events are relayed at least once, so
+// HandleTaskEvent must tolerate seeing an event again.
+type Subscriber interface {
+	HandleTaskEvent(ctx context.Context, event domain.TaskEvent) error
+}
+
+// Config tunes how events are relayed.
+type Config struct {
+	// RetryBackoff is the wait after the first failed attempt to relay an
+	// event. It doubles with every further failure, up to MaxBackoff. Events
+	// are retried until they are relayed; later events of the same task wait.
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	// Lease is how long a claimed event is held before another relay may
+	// claim it again.
+	Lease     time.Duration
+	BatchSize int
+}
+
+var DefaultConfig = Config{
+	RetryBackoff: 5 * time.Second,
+	MaxBackoff:   10 * time.Minute,
+	Lease:        time.Minute,
+	BatchSize:    100,
+}
+
+// ConfigFromEnv reads OUTBOX_RETRY_BACKOFF, falling back to DefaultConfig.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+	if raw := os.Getenv("OUTBOX_RETRY_BACKOFF"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid OUTBOX_RETRY_BACKOFF: %q", raw)
+		}
+		cfg.RetryBackoff = d
+	}
+	return cfg, nil
+}
+
+type Relay struct {
+	repo        Repository
+	subscribers []Subscriber
+	cfg         Config
+}
+
+func NewRelay(repo Repository, subscribers []Subscriber, cfg Config) *Relay {
+	return &Relay{repo: repo, subscribers: subscribers, cfg: cfg}
+}
+
+// RelayDue hands the events that are due to every subscriber and returns how
+// many were relayed. An event is only removed from the outbox once all
+// subscribers have handled it; otherwise it is retried with exponential
+// backoff and every subscriber sees it again.
+func (r *Relay) RelayDue(ctx context.Context, now time.Time) (int, error) {
+	relayed := 0
+	var errs []error
+	for {
+		due, err := r.repo.ClaimDue(ctx, now, r.cfg.Lease, r.cfg.BatchSize)
+		if err != nil {
+			return relayed, errors.Join(append(errs, err)...)
+		}
+		if len(due) == 0 {
+			return relayed, errors.Join(errs...)
+		}
+
+		for i := range due {
+			e := &due[i]
+			if err := r.relay(ctx, e.Event); err != nil {
+				e.Attempts++
+				e.LastError = err.Error()
+				e.AvailableAt = now.Add(r.backoff(e.Attempts))
+				log.Printf("outbox: relaying %s of task %s failed (attempt %d), retrying at %s: %v",
+					e.Event.Type, e.Event.Task.ID, e.Attempts, e.AvailableAt.Format(time.RFC3339), err)
+				if err := r.repo.Reschedule(ctx, e); err != nil {
+					errs = append(errs, fmt.Errorf("reschedule event %s: %w", e.Event.ID, err))
+				}
+				continue
+			}
+			if err := r.repo.MarkRelayed(ctx, e); err != nil {
+				errs = append(errs, fmt.Errorf("mark event %s relayed: %w", e.Event.ID, err))
+				continue
+			}
+			relayed++
+		}
+
+		if ctx.Err() != nil {
+			return relayed, errors.Join(errs...)
+		}
+	}
+}
+
+func (r *Relay) relay(ctx context.Context, event domain.TaskEvent) error {
+	var errs []error
+	for _, s := range r.subscribers {
+		if err := s.HandleTaskEvent(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (r *Relay) backoff(attempts int) time.Duration {
+	wait := r.cfg.RetryBackoff
+	for i := 1; i < attempts && wait < r.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, r.cfg.MaxBackoff)
+}
@@ -0,0 +1,155 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/outbox"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryOutbox stands in for the outbox table: it hands out only the oldest
+// remaining event of each task, like the postgres repository.
+type memoryOutbox struct {
+	events []domain.OutboxEvent
+	next   int64
+}
+
+func (m *memoryOutbox) Append(_ context.Context, events ...domain.TaskEvent) error {
+	for _, e := range events {
+		m.next++
+		m.events = append(m.events, domain.OutboxEvent{Sequence: m.next, Event: e})
+	}
+	return nil
+}
+
+func (m *memoryOutbox) ClaimDue(
+	_ context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]domain.OutboxEvent, error) {
+	sort.Slice(m.events, func(i, j int) bool { return m.events[i].Sequence < m.events[j].Sequence })
+
+	var claimed []domain.OutboxEvent
+	seen := make(map[uuid.UUID]bool)
+	for i := range m.events {
+		e := &m.events[i]
+		head := !seen[e.Event.Task.ID]
+		seen[e.Event.Task.ID] = true
+		if head && !e.AvailableAt.After(now) && len(claimed) < limit {
+			e.AvailableAt = now.Add(lease)
+			claimed = append(claimed, *e)
+		}
+	}
+	return claimed, nil
+}
+
+func (m *memoryOutbox) MarkRelayed(_ context.Context, event *domain.OutboxEvent) error {
+	for i, e := range m.events {
+		if e.Sequence == event.Sequence {
+			m.events = append(m.events[:i], m.events[i+1:]...)
+			return nil
+		}
+	}
+	return nil
+}
+
+func (m *memoryOutbox) Reschedule(_ context.Context, event *domain.OutboxEvent) error {
+	for i, e := range m.events {
+		if e.Sequence == event.Sequence {
+			m.events[i] = *event
+		}
+	}
+	return nil
+}
+
+// recorder records the events it handles and fails the ones in fail.
+type recorder struct {
+	handled []domain.TaskEvent
+	fail    map[uuid.UUID]bool
+}
+
+func (r *recorder) HandleTaskEvent(_ context.Context, e domain.TaskEvent) error {
+	r.handled = append(r.handled, e)
+	if r.fail[e.ID] {
+		return errors.New("subscriber unavailable")
+	}
+	return nil
+}
+
+func event(taskID uuid.UUID, eventType domain.TaskEventType) domain.TaskEvent {
+	return domain.TaskEvent{ID: uuid.New(), Type: eventType, Task: domain.Task{ID: taskID}}
+}
+
+func TestRelay_RelayDue_InOrderPerTask(t *testing.T) {
+	store := &memoryOutbox{}
+	first, second := &recorder{}, &recorder{}
+	relay := outbox.NewRelay(store, []outbox.Subscriber{first, second}, outbox.DefaultConfig)
+
+	a, b := uuid.New(), uuid.New()
+	events := []domain.TaskEvent{
+		event(a, domain.TaskCreated),
+		event(b, domain.TaskCreated),
+		event(a, domain.TaskUpdated),
+		event(a, domain.TaskCommented),
+	}
+	require.NoError(t, store.Append(context.Background(), events...))
+
+	relayed, err := relay.RelayDue(context.Background(), time.Now())
+
+	require.NoError(t, err)
+	assert.Equal(t, 4, relayed)
+	assert.Empty(t, store.events)
+	for _, sub := range []*recorder{first, second} {
+		var forA []domain.TaskEventType
+		for _, e := range sub.handled {
+			if e.Task.ID == a {
+				forA = append(forA, e.Type)
+			}
+		}
+		assert.Equal(t, []domain.TaskEventType{domain.TaskCreated, domain.TaskUpdated, domain.TaskCommented}, forA)
+		assert.Len(t, sub.handled, 4)
+	}
+}
+
+func TestRelay_RelayDue_RetriesWithoutOvertaking(t *testing.T) {
+	store := &memoryOutbox{}
+	created := event(uuid.New(), domain.TaskCreated)
+	updated := event(created.Task.ID, domain.TaskUpdated)
+	sub := &recorder{fail: map[uuid.UUID]bool{created.ID: true}}
+	relay := outbox.NewRelay(store, []outbox.Subscriber{sub}, outbox.DefaultConfig)
+	require.NoError(t, store.Append(context.Background(), created, updated))
+
+	now := time.Now()
+	relayed, err := relay.RelayDue(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, relayed)
+	// The update waits behind the failed creation.
+	assert.Equal(t, []domain.TaskEvent{created}, sub.handled)
+	require.Len(t, store.events, 2)
+	assert.Equal(t, 1, store.events[0].Attempts)
+	assert.Equal(t, now.Add(outbox.DefaultConfig.RetryBackoff), store.events[0].AvailableAt)
+	assert.Equal(t, "subscriber unavailable", store.events[0].LastError)
+
+	// Nothing is due until the backoff has passed.
+	relayed, err = relay.RelayDue(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, relayed)
+
+	sub.fail = nil
+	relayed, err = relay.RelayDue(context.Background(), now.Add(outbox.DefaultConfig.RetryBackoff))
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, relayed)
+	assert.Equal(t, []domain.TaskEvent{created, created, updated}, sub.handled)
+	assert.Empty(t, store.events)
+}
@@ -0,0 +1,109 @@
+// Package authctx carries the authenticated caller through a request context.
+package authctx
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type (
+	principalKey struct{}
+	systemKey    struct{}
+)
+
+// RoleAdmin is the realm role of administrators.
+const RoleAdmin = "admin"
+
+// Principal is the authenticated caller, as described by their access token.
+type Principal struct {
+	UserID   uuid.UUID
+	Username string
+	Email    string
+	Name     string
+	// RealmRoles are the caller's Keycloak realm roles.
+	RealmRoles []string
+	// ClientRoles are the caller's roles in each Keycloak client, by client ID.
+	ClientRoles map[string][]string
+	// Groups are the paths of the caller's Keycloak groups, such as "/engineering".
+	Groups []string
+}
+
+func (p Principal) HasRealmRole(role string) bool {
+	return slices.Contains(p.RealmRoles, role)
+}
+
+func (p Principal) HasClientRole(clientID, role string) bool {
+	return slices.Contains(p.ClientRoles[clientID], role)
+}
+
+func (p Principal) InGroup(group string) bool {
+	return slices.Contains(p.Groups, group)
+}
+
+// WithPrincipal returns a copy of ctx carrying the caller. Tests use it to act
+// as any user:
+//
+//	ctx := authctx.WithPrincipal(ctx, authctx.Principal{UserID: id, RealmRoles: []string{authctx.RoleAdmin}})
+func WithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFrom returns the caller stored by WithPrincipal, if any.
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// WithUserID returns a copy of ctx whose caller has the given ID.
+func WithUserID(ctx context.Context, userID uuid.UUID) context.Context {
+	principal, _ := PrincipalFrom(ctx)
+	principal.UserID = userID
+	return WithPrincipal(ctx, principal)
+}
+
+// AsUser returns a copy of ctx whose caller is only the given user, without
+// the roles of the current caller or the marking of AsSystem. It lets a check
+// be made on behalf of someone other than the caller.
+func AsUser(ctx context.Context, userID uuid.UUID) context.Context {
+	ctx = context.WithValue(ctx, systemKey{}, false)
+	return WithPrincipal(ctx, Principal{UserID: userID})
+}
+
+// UserID returns the ID of the caller, if any.
+func UserID(ctx context.Context) (uuid.UUID, bool) {
+	principal, ok := PrincipalFrom(ctx)
+	return principal.UserID, ok && principal.UserID != uuid.Nil
+}
+
+// WithRoles returns a copy of ctx whose caller holds the given realm roles.
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	principal, _ := PrincipalFrom(ctx)
+	principal.RealmRoles = roles
+	return WithPrincipal(ctx, principal)
+}
+
+// HasRole reports whether the caller holds the realm role.
+func HasRole(ctx context.Context, role string) bool {
+	principal, _ := PrincipalFrom(ctx)
+	return principal.HasRealmRole(role)
+}
+
+// HasClientRole reports whether the caller holds the role in the client.
+func HasClientRole(ctx context.Context, clientID, role string) bool {
+	principal, _ := PrincipalFrom(ctx)
+	return principal.HasClientRole(clientID, role)
+}
+
+// AsSystem returns a copy of ctx for work the application does on its own
+// behalf, such as background jobs. It passes every permission check.
+func AsSystem(ctx context.Context) context.Context {
+	return context.WithValue(ctx, systemKey{}, true)
+}
+
+// IsSystem reports whether ctx was marked by AsSystem.
+func IsSystem(ctx context.Context) bool {
+	system, _ := ctx.Value(systemKey{}).(bool)
+	return system
+}
@@ -1,63 +1,247 @@
 package jwtutil
 
 import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rsa"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
-	"io"
+	"fmt"
 	"math/big"
 	"net/http"
 	"os"
+	"strings"
+	"sync"
+	"time"
 )
 
+// ErrUnknownKey is returned for tokens signed with a key the issuer does not
+// publish.
+var ErrUnknownKey = errors.New("unknown signing key")
+
+// KeySource resolves the public key a token was signed with from the kid and
+// alg of its header.
+type KeySource interface {
+	Key(kid, alg string) (crypto.PublicKey, error)
+}
+
 type JWKS struct {
 	Keys []JWK `json:"keys"`
 }
 
 type JWK struct {
+	Kid string `json:"kid"`
 	Kty string `json:"kty"`
-	N   string `json:"n"`
-	E   string `json:"e"`
+	// Use is "sig" for signing keys and "enc" for encryption keys.
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// RSA keys
+	N string `json:"n"`
+	E string `json:"e"`
+	// EC keys
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+type JWKSConfig struct {
+	URL string
+	// MinRefetchInterval limits how often a token with an unknown kid makes
+	// the cache fetch the key set again.
+	MinRefetchInterval time.Duration
+	Timeout            time.Duration
+}
+
+var DefaultJWKSConfig = JWKSConfig{
+	MinRefetchInterval: 10 * time.Second,
+	Timeout:            5 * time.Second,
+}
+
+// JWKSConfigFromEnv reads the certificates of the KEYCLOAK_REALM realm at
+// KEYCLOAK_BASE_URL, or JWKS_URL when set, and JWKS_MIN_REFETCH_INTERVAL.
+func JWKSConfigFromEnv() (JWKSConfig, error) {
+	cfg := DefaultJWKSConfig
+	cfg.URL = os.Getenv("JWKS_URL")
+	if cfg.URL == "" {
+		base, realm := os.Getenv("KEYCLOAK_BASE_URL"), os.Getenv("KEYCLOAK_REALM")
+		if base == "" || realm == "" {
+			return JWKSConfig{}, errors.New("JWKS_URL or KEYCLOAK_BASE_URL and KEYCLOAK_REALM must be set")
+		}
+		cfg.URL = strings.TrimSuffix(base, "/") + "/realms/" + realm + "/protocol/openid-connect/certs"
+	}
+	if raw := os.Getenv("JWKS_MIN_REFETCH_INTERVAL"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d < 0 {
+			return JWKSConfig{}, fmt.Errorf("invalid JWKS_MIN_REFETCH_INTERVAL: %q", raw)
+		}
+		cfg.MinRefetchInterval = d
+	}
+	return cfg, nil
 }
 
-func FetchRSAPublicKeyFromJWKS() (*rsa.PublicKey, error) {
-	baseURL := os.Getenv("KEYCLOAK_BASE_URL")
-	realm := os.Getenv("KEYCLOAK_REALM")
+type signingKey struct {
+	alg string
+	key crypto.PublicKey
+}
+
+// JWKSCache keeps the signing keys of an issuer by kid. Refresh replaces them,
+// so keys that the issuer rotates out stop being accepted; a token signed
+// with a key that is not known yet makes the cache refetch the set, at most
+// once per MinRefetchInterval.
+type JWKSCache struct {
+	cfg    JWKSConfig
+	client *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]signingKey
 
-	resp, err := http.Get(baseURL + "/realms/" + realm + "/protocol/openid-connect/certs")
+	// refetchMu serializes refetches for unknown kids.
+	refetchMu   sync.Mutex
+	lastRefetch time.Time
+}
+
+// NewJWKSCache returns an empty cache. Keys are fetched by Refresh, or on
+// first use.
+func NewJWKSCache(cfg JWKSConfig) *JWKSCache {
+	return &JWKSCache{
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+		keys:   make(map[string]signingKey),
+	}
+}
+
+// Key returns the signing key with the kid. A token without a kid may only
+// use the issuer's sole signing key.
+func (c *JWKSCache) Key(kid, alg string) (crypto.PublicKey, error) {
+	k, ok := c.lookup(kid)
+	if !ok && c.refetch() {
+		k, ok = c.lookup(kid)
+	}
+	if !ok {
+		return nil, fmt.Errorf("%w: kid %q", ErrUnknownKey, kid)
+	}
+	if k.alg != "" && k.alg != alg {
+		return nil, fmt.Errorf("%w: key %q is for %s", ErrUnexpectedAlgorithm, kid, k.alg)
+	}
+	return k.key, nil
+}
+
+func (c *JWKSCache) lookup(kid string) (signingKey, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if kid == "" {
+		if len(c.keys) != 1 {
+			return signingKey{}, false
+		}
+		for _, k := range c.keys {
+			return k, true
+		}
+	}
+	k, ok := c.keys[kid]
+	return k, ok
+}
+
+// refetch refreshes the keys unless that was tried within
+// MinRefetchInterval, and reports whether it did.
+func (c *JWKSCache) refetch() bool {
+	c.refetchMu.Lock()
+	defer c.refetchMu.Unlock()
+	if time.Since(c.lastRefetch) < c.cfg.MinRefetchInterval {
+		return false
+	}
+	c.lastRefetch = time.Now()
+	return c.Refresh(context.Background()) == nil
+}
+
+// Refresh fetches the key set and replaces the cached keys. The cache is left
+// unchanged when the set cannot be fetched or holds no usable signing key.
+func (c *JWKSCache) Refresh(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.cfg.URL, nil)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("fetching JWKS: %s", resp.Status)
 	}
-	defer func(Body io.ReadCloser) {
-		_ = Body.Close()
-	}(resp.Body)
 
 	var jwks JWKS
 	if err := json.NewDecoder(resp.Body).Decode(&jwks); err != nil {
-		return nil, err
+		return fmt.Errorf("decoding JWKS: %w", err)
 	}
 
-	if len(jwks.Keys) == 0 {
-		return nil, errors.New("no keys found in JWKS")
+	keys := make(map[string]signingKey, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.PublicKey()
+		if err != nil {
+			// Keys of other types do not stop the ones we can use.
+			continue
+		}
+		keys[jwk.Kid] = signingKey{alg: jwk.Alg, key: key}
+	}
+	if len(keys) == 0 {
+		return errors.New("no usable signing keys in JWKS")
 	}
 
-	key := jwks.Keys[0]
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+	return nil
+}
 
-	nBytes, err := base64.RawURLEncoding.DecodeString(key.N)
-	if err != nil {
-		return nil, err
+// PublicKey decodes an RSA or EC key.
+func (k JWK) PublicKey() (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
 	}
+}
 
-	eBytes, err := base64.RawURLEncoding.DecodeString(key.E)
+func decodeInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
 	if err != nil {
 		return nil, err
 	}
-
-	pubKey := &rsa.PublicKey{
-		N: new(big.Int).SetBytes(nBytes),
-		E: int(new(big.Int).SetBytes(eBytes).Int64()),
-	}
-
-	return pubKey, nil
+	return new(big.Int).SetBytes(b), nil
 }
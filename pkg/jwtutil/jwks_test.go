@@ -0,0 +1,86 @@
+package jwtutil_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"task-manager/pkg/jwtutil"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWKSCache(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	b64 := func(i *big.Int) string { return base64.RawURLEncoding.EncodeToString(i.Bytes()) }
+	jwks := jwtutil.JWKS{Keys: []jwtutil.JWK{
+		// Keycloak also lists its encryption key, which must not verify tokens.
+		{Kid: "enc", Kty: "RSA", Use: "enc", Alg: "RSA-OAEP", N: b64(rsaKey.N), E: b64(big.NewInt(int64(rsaKey.E)))},
+		{Kid: "rsa", Kty: "RSA", Use: "sig", Alg: "RS256", N: b64(rsaKey.N), E: b64(big.NewInt(int64(rsaKey.E)))},
+		{Kid: "ec", Kty: "EC", Use: "sig", Alg: "ES256", Crv: "P-256", X: b64(ecKey.X), Y: b64(ecKey.Y)},
+	}}
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		fetches.Add(1)
+		_ = json.NewEncoder(w).Encode(jwks)
+	}))
+	defer srv.Close()
+
+	cache := jwtutil.NewJWKSCache(jwtutil.JWKSConfig{URL: srv.URL, MinRefetchInterval: time.Minute, Timeout: time.Second})
+
+	require.NoError(t, cache.Refresh(context.Background()))
+	key, err := cache.Key("rsa", "RS256")
+	require.NoError(t, err)
+	assert.True(t, rsaKey.PublicKey.Equal(key))
+	key, err = cache.Key("ec", "ES256")
+	require.NoError(t, err)
+	assert.True(t, ecKey.PublicKey.Equal(key))
+	assert.EqualValues(t, 1, fetches.Load())
+
+	_, err = cache.Key("ec", "RS256")
+	assert.ErrorIs(t, err, jwtutil.ErrUnexpectedAlgorithm)
+
+	// An unknown kid refetches the set, but only once per interval.
+	_, err = cache.Key("enc", "RS256")
+	assert.ErrorIs(t, err, jwtutil.ErrUnknownKey)
+	_, err = cache.Key("rotated", "RS256")
+	assert.ErrorIs(t, err, jwtutil.ErrUnknownKey)
+	assert.EqualValues(t, 2, fetches.Load())
+
+	cfg := jwtutil.DefaultConfig
+	cfg.Algorithms = []string{"RS256", "ES256"}
+	cfg.TokenType = ""
+	validator := jwtutil.NewValidator(cfg, cache)
+	now := time.Now()
+	claims := jwt.MapClaims{"sub": "7c1b5c55-4d43-4bd6-9c6b-8e2f0a1d2b3c", "iat": now.Unix(), "exp": now.Add(time.Minute).Unix()}
+
+	es256 := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	es256.Header["kid"] = "ec"
+	token, err := es256.SignedString(ecKey)
+	require.NoError(t, err)
+	_, err = validator.Validate(token)
+	assert.NoError(t, err)
+
+	// An ES256 token cannot name the RSA key.
+	es256.Header["kid"] = "rsa"
+	token, err = es256.SignedString(ecKey)
+	require.NoError(t, err)
+	_, err = validator.Validate(token)
+	assert.ErrorIs(t, err, jwtutil.ErrUnexpectedAlgorithm)
+}
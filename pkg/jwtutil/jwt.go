@@ -1,28 +1,205 @@
 package jwtutil
 
 import (
+	"crypto/ecdsa"
 	"crypto/rsa"
 	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-func ValidateToken(tokenStr string, publicKey *rsa.PublicKey) (jwt.MapClaims, error) {
-	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodRSA); !ok {
-			return nil, errors.New("unexpected signing method")
+// Reasons a token is rejected. Validate wraps one of them in every error it
+// returns.
+var (
+	ErrMalformedToken      = errors.New("malformed token")
+	ErrUnexpectedAlgorithm = errors.New("unexpected signing algorithm")
+	ErrInvalidSignature    = errors.New("invalid token signature")
+	ErrTokenExpired        = errors.New("token expired")
+	ErrTokenNotYetValid    = errors.New("token not valid yet")
+	ErrInvalidIssuer       = errors.New("unexpected token issuer")
+	ErrInvalidAudience     = errors.New("token not issued for this API")
+	ErrInvalidTokenType    = errors.New("unexpected token type")
+	ErrMissingClaim        = errors.New("token is missing a required claim")
+)
+
+// Config is what a token must satisfy besides a valid signature.
+type Config struct {
+	// Issuer is the expected iss claim; empty accepts any issuer.
+	Issuer string
+	// Audiences are the clients tokens may be issued for. A token is accepted
+	// when its aud or azp claim names one of them; empty accepts any.
+	Audiences []string
+	// Algorithms are the accepted signing algorithms, such as RS256 and
+	// ES256.
+	Algorithms []string
+	// Leeway is the clock skew tolerated on exp, nbf and iat.
+	Leeway         time.Duration
+	RequiredClaims []string
+	// TokenType is the expected Keycloak typ claim, "Bearer" for access
+	// tokens; empty accepts any type.
+	TokenType string
+}
+
+var DefaultConfig = Config{
+	Algorithms:     []string{"RS256"},
+	Leeway:         30 * time.Second,
+	RequiredClaims: []string{"exp", "iat", "sub"},
+	TokenType:      "Bearer",
+}
+
+// ConfigFromEnv expects tokens issued by the KEYCLOAK_REALM realm at
+// KEYCLOAK_BASE_URL for KEYCLOAK_CLIENT_ID. JWT_ISSUER, JWT_AUDIENCES,
+// JWT_ALGORITHMS, JWT_LEEWAY and JWT_REQUIRED_CLAIMS override the defaults;
+// the lists are comma-separated.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+	if base, realm := os.Getenv("KEYCLOAK_BASE_URL"), os.Getenv("KEYCLOAK_REALM"); base != "" && realm != "" {
+		cfg.Issuer = strings.TrimSuffix(base, "/") + "/realms/" + realm
+	}
+	if clientID := os.Getenv("KEYCLOAK_CLIENT_ID"); clientID != "" {
+		cfg.Audiences = []string{clientID}
+	}
+
+	if raw := os.Getenv("JWT_ISSUER"); raw != "" {
+		cfg.Issuer = raw
+	}
+	if raw := os.Getenv("JWT_AUDIENCES"); raw != "" {
+		cfg.Audiences = splitList(raw)
+	}
+	if raw := os.Getenv("JWT_ALGORITHMS"); raw != "" {
+		cfg.Algorithms = splitList(raw)
+		for _, alg := range cfg.Algorithms {
+			if jwt.GetSigningMethod(alg) == nil {
+				return Config{}, fmt.Errorf("invalid JWT_ALGORITHMS: unknown algorithm %q", alg)
+			}
 		}
-		return publicKey, nil
-	})
+	}
+	if raw := os.Getenv("JWT_LEEWAY"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d < 0 {
+			return Config{}, fmt.Errorf("invalid JWT_LEEWAY: %q", raw)
+		}
+		cfg.Leeway = d
+	}
+	if raw := os.Getenv("JWT_REQUIRED_CLAIMS"); raw != "" {
+		cfg.RequiredClaims = splitList(raw)
+	}
+	return cfg, nil
+}
+
+func splitList(raw string) []string {
+	var values []string
+	for _, v := range strings.Split(raw, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// Validator checks the signature and claims of access tokens.
+type Validator struct {
+	cfg    Config
+	keys   KeySource
+	parser *jwt.Parser
+}
 
-	if err != nil || !token.Valid {
-		return nil, errors.New("invalid token")
+func NewValidator(cfg Config, keys KeySource) *Validator {
+	opts := []jwt.ParserOption{jwt.WithLeeway(cfg.Leeway), jwt.WithIssuedAt()}
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.WithIssuer(cfg.Issuer))
 	}
+	return &Validator{cfg: cfg, keys: keys, parser: jwt.NewParser(opts...)}
+}
 
-	claims, ok := token.Claims.(jwt.MapClaims)
-	if !ok {
-		return nil, errors.New("invalid claims")
+// Validate returns the claims of a token that satisfies the configuration.
+// Errors wrap one of the Err* reasons of this package.
+func (v *Validator) Validate(tokenStr string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	if _, err := v.parser.ParseWithClaims(tokenStr, claims, v.keyFunc); err != nil {
+		return nil, reason(err)
 	}
 
+	for _, name := range v.cfg.RequiredClaims {
+		if _, ok := claims[name]; !ok {
+			return nil, fmt.Errorf("%w: %s", ErrMissingClaim, name)
+		}
+	}
+	if v.cfg.TokenType != "" {
+		if typ, _ := claims["typ"].(string); typ != v.cfg.TokenType {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidTokenType, typ)
+		}
+	}
+	if len(v.cfg.Audiences) > 0 && !v.forAudience(claims) {
+		return nil, ErrInvalidAudience
+	}
 	return claims, nil
 }
+
+func (v *Validator) keyFunc(token *jwt.Token) (interface{}, error) {
+	// The algorithm is pinned here rather than with jwt.WithValidMethods so
+	// that the rejection can be told apart from a bad signature.
+	alg := token.Method.Alg()
+	if !slices.Contains(v.cfg.Algorithms, alg) {
+		return nil, fmt.Errorf("%w: %s", ErrUnexpectedAlgorithm, alg)
+	}
+
+	kid, _ := token.Header["kid"].(string)
+	key, err := v.keys.Key(kid, alg)
+	if err != nil {
+		return nil, err
+	}
+	// The key type must match the algorithm family.
+	switch key.(type) {
+	case *rsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodRSA); ok {
+			return key, nil
+		}
+	case *ecdsa.PublicKey:
+		if _, ok := token.Method.(*jwt.SigningMethodECDSA); ok {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s with key %q", ErrUnexpectedAlgorithm, alg, kid)
+}
+
+// forAudience reports whether the token was issued for one of the allowed
+// audiences. Keycloak names the requesting client in azp and lists the
+// resource servers in aud.
+func (v *Validator) forAudience(claims jwt.MapClaims) bool {
+	if azp, _ := claims["azp"].(string); slices.Contains(v.cfg.Audiences, azp) {
+		return true
+	}
+	aud, _ := claims.GetAudience()
+	for _, a := range aud {
+		if slices.Contains(v.cfg.Audiences, a) {
+			return true
+		}
+	}
+	return false
+}
+
+// reason maps an error of the jwt package to the reason it stands for.
+func reason(err error) error {
+	switch {
+	case errors.Is(err, ErrUnexpectedAlgorithm), errors.Is(err, ErrUnknownKey):
+		return err
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid):
+		return ErrInvalidSignature
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return ErrTokenExpired
+	case errors.Is(err, jwt.ErrTokenNotValidYet), errors.Is(err, jwt.ErrTokenUsedBeforeIssued):
+		return ErrTokenNotYetValid
+	case errors.Is(err, jwt.ErrTokenInvalidIssuer):
+		return ErrInvalidIssuer
+	case errors.Is(err, jwt.ErrTokenRequiredClaimMissing):
+		return fmt.Errorf("%w: %v", ErrMissingClaim, err)
+	default:
+		return fmt.Errorf("%w: %v", ErrMalformedToken, err)
+	}
+}
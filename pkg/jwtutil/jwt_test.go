@@ -0,0 +1,103 @@
+package jwtutil_test
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"task-manager/pkg/jwtutil"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const issuer = "http://keycloak:8080/realms/task-manager"
+
+// staticKey is a KeySource with a single key.
+type staticKey struct{ key crypto.PublicKey }
+
+func (s staticKey) Key(string, string) (crypto.PublicKey, error) { return s.key, nil }
+
+func TestValidator_Validate(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	cfg := jwtutil.DefaultConfig
+	cfg.Issuer = issuer
+	cfg.Audiences = []string{"task-client"}
+	validator := jwtutil.NewValidator(cfg, staticKey{&key.PublicKey})
+
+	now := time.Now()
+	claims := func(edit func(jwt.MapClaims)) jwt.MapClaims {
+		c := jwt.MapClaims{
+			"iss": issuer,
+			"sub": "7c1b5c55-4d43-4bd6-9c6b-8e2f0a1d2b3c",
+			"aud": "account",
+			"azp": "task-client",
+			"typ": "Bearer",
+			"iat": now.Unix(),
+			"exp": now.Add(5 * time.Minute).Unix(),
+		}
+		if edit != nil {
+			edit(c)
+		}
+		return c
+	}
+	sign := func(c jwt.MapClaims) string {
+		token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, c).SignedString(key)
+		require.NoError(t, err)
+		return token
+	}
+
+	_, err = validator.Validate(sign(claims(nil)))
+	assert.NoError(t, err)
+	// Clocks a few seconds apart are tolerated.
+	_, err = validator.Validate(sign(claims(func(c jwt.MapClaims) { c["exp"] = now.Add(-10 * time.Second).Unix() })))
+	assert.NoError(t, err)
+
+	hs256, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims(nil)).SignedString([]byte("secret"))
+	require.NoError(t, err)
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	forged, err := jwt.NewWithClaims(jwt.SigningMethodRS256, claims(nil)).SignedString(other)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		token string
+		want  error
+	}{
+		"malformed": {"not-a-token", jwtutil.ErrMalformedToken},
+		"algorithm": {hs256, jwtutil.ErrUnexpectedAlgorithm},
+		"signature": {forged, jwtutil.ErrInvalidSignature},
+		"expired": {
+			sign(claims(func(c jwt.MapClaims) { c["exp"] = now.Add(-time.Minute).Unix() })),
+			jwtutil.ErrTokenExpired,
+		},
+		"issued in the future": {
+			sign(claims(func(c jwt.MapClaims) { c["iat"] = now.Add(time.Minute).Unix() })),
+			jwtutil.ErrTokenNotYetValid,
+		},
+		"issuer": {
+			sign(claims(func(c jwt.MapClaims) { c["iss"] = "http://keycloak:8080/realms/other" })),
+			jwtutil.ErrInvalidIssuer,
+		},
+		"audience": {
+			sign(claims(func(c jwt.MapClaims) { c["azp"] = "other-client" })),
+			jwtutil.ErrInvalidAudience,
+		},
+		"type": {
+			sign(claims(func(c jwt.MapClaims) { c["typ"] = "Refresh" })),
+			jwtutil.ErrInvalidTokenType,
+		},
+		"required claim": {
+			sign(claims(func(c jwt.MapClaims) { delete(c, "sub") })),
+			jwtutil.ErrMissingClaim,
+		},
+	} {
+		_, err := validator.Validate(tc.token)
+		assert.ErrorIs(t, err, tc.want, name)
+	}
+}
@@ -0,0 +1,62 @@
+package jwtutil
+
+import (
+	"errors"
+
+	"task-manager/pkg/authctx"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidSubject is returned for tokens whose sub claim is not a user ID.
+var ErrInvalidSubject = errors.New("invalid subject claim")
+
+// ParsePrincipal reads the caller from Keycloak access token claims: the user
+// from sub, preferred_username, email and name, realm roles from
+// realm_access.roles, client roles from resource_access.<client>.roles and
+// groups from groups.
+func ParsePrincipal(claims jwt.MapClaims) (authctx.Principal, error) {
+	sub, _ := claims["sub"].(string)
+	userID, err := uuid.Parse(sub)
+	if err != nil {
+		return authctx.Principal{}, ErrInvalidSubject
+	}
+
+	principal := authctx.Principal{
+		UserID:     userID,
+		RealmRoles: roles(claims["realm_access"]),
+		Groups:     stringList(claims["groups"]),
+	}
+	principal.Username, _ = claims["preferred_username"].(string)
+	principal.Email, _ = claims["email"].(string)
+	principal.Name, _ = claims["name"].(string)
+
+	resources, _ := claims["resource_access"].(map[string]interface{})
+	for clientID, access := range resources {
+		if r := roles(access); len(r) > 0 {
+			if principal.ClientRoles == nil {
+				principal.ClientRoles = make(map[string][]string)
+			}
+			principal.ClientRoles[clientID] = r
+		}
+	}
+	return principal, nil
+}
+
+// roles reads the roles list of a realm_access or resource_access entry.
+func roles(access interface{}) []string {
+	m, _ := access.(map[string]interface{})
+	return stringList(m["roles"])
+}
+
+func stringList(raw interface{}) []string {
+	list, _ := raw.([]interface{})
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,43 @@
+package jwtutil_test
+
+import (
+	"testing"
+
+	"task-manager/pkg/jwtutil"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePrincipal(t *testing.T) {
+	userID := uuid.New()
+	claims := jwt.MapClaims{
+		"sub":                userID.String(),
+		"preferred_username": "jane",
+		"email":              "jane@example.com",
+		"name":               "Jane Doe",
+		"realm_access":       map[string]interface{}{"roles": []interface{}{"admin", "offline_access"}},
+		"resource_access": map[string]interface{}{
+			"task-manager": map[string]interface{}{"roles": []interface{}{"auditor"}},
+			"account":      map[string]interface{}{"roles": []interface{}{}},
+		},
+		"groups": []interface{}{"/engineering"},
+	}
+
+	p, err := jwtutil.ParsePrincipal(claims)
+
+	require.NoError(t, err)
+	assert.Equal(t, userID, p.UserID)
+	assert.Equal(t, "jane", p.Username)
+	assert.Equal(t, "jane@example.com", p.Email)
+	assert.Equal(t, "Jane Doe", p.Name)
+	assert.True(t, p.HasRealmRole("admin"))
+	assert.True(t, p.HasClientRole("task-manager", "auditor"))
+	assert.NotContains(t, p.ClientRoles, "account")
+	assert.True(t, p.InGroup("/engineering"))
+
+	_, err = jwtutil.ParsePrincipal(jwt.MapClaims{"sub": "service-account"})
+	assert.ErrorIs(t, err, jwtutil.ErrInvalidSubject)
+}
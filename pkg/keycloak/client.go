@@ -2,18 +2,26 @@ package keycloak
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
 )
 
 type Client struct {
 	baseURL string
 	realm   string
 	client  *resty.Client
-	token   string
+
+	// mu guards the cached admin token.
+	mu          sync.Mutex
+	adminToken  string
+	adminExpiry time.Time
 }
 
 func NewClient() *Client {
@@ -24,36 +32,64 @@ func NewClient() *Client {
 	}
 }
 
-func (c *Client) getAdminToken(ctx context.Context) error {
+// adminTokenLeeway renews the admin token this long before it expires, so
+// that it does not run out during a request.
+const adminTokenLeeway = 30 * time.Second
+
+// getAdminToken returns a token for the Admin API, reusing it until it is
+// about to expire. It uses the client credentials of the service account of
+// KEYCLOAK_ADMIN_CLIENT_ID when KEYCLOAK_ADMIN_CLIENT_SECRET is set, and the
+// master realm admin password otherwise.
+func (c *Client) getAdminToken(ctx context.Context) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.adminToken != "" && time.Now().Before(c.adminExpiry) {
+		return c.adminToken, nil
+	}
+
+	realm := "master"
+	form := map[string]string{
+		"grant_type": "password",
+		"client_id":  "admin-cli",
+		"username":   os.Getenv("KEYCLOAK_ADMIN_USERNAME"),
+		"password":   os.Getenv("KEYCLOAK_ADMIN_PASSWORD"),
+	}
+	if secret := os.Getenv("KEYCLOAK_ADMIN_CLIENT_SECRET"); secret != "" {
+		realm = c.realm
+		form = map[string]string{
+			"grant_type":    "client_credentials",
+			"client_id":     os.Getenv("KEYCLOAK_ADMIN_CLIENT_ID"),
+			"client_secret": secret,
+		}
+	}
+
+	var token Token
+	var oauthErr oauthError
 	resp, err := c.client.R().
 		SetContext(ctx).
-		SetFormData(
-			map[string]string{
-				"grant_type": "password",
-				"client_id":  "admin-cli",
-				"username":   os.Getenv("KEYCLOAK_ADMIN_USERNAME"),
-				"password":   os.Getenv("KEYCLOAK_ADMIN_PASSWORD"),
-			},
-		).
+		SetFormData(form).
 		SetHeader("Content-Type", "application/x-www-form-urlencoded").
-		SetResult(map[string]interface{}{}).
-		Post(fmt.Sprintf("%s/realms/master/protocol/openid-connect/token", c.baseURL))
-
+		SetResult(&token).
+		SetError(&oauthErr).
+		Post(fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", c.baseURL, realm))
 	if err != nil {
-		return err
+		return "", err
 	}
-
-	result := resp.Result().(*map[string]interface{})
-	if token, ok := (*result)["access_token"].(string); ok {
-		c.token = token
-		return nil
+	if err := refused(resp, oauthErr); err != nil {
+		return "", fmt.Errorf("failed to get admin token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return "", fmt.Errorf("failed to get admin token")
 	}
 
-	return fmt.Errorf("failed to get admin token")
+	c.adminToken = token.AccessToken
+	c.adminExpiry = time.Now().Add(time.Duration(token.ExpiresIn)*time.Second - adminTokenLeeway)
+	return c.adminToken, nil
 }
 
 func (c *Client) CreateUser(ctx context.Context, name, email, password string) error {
-	if err := c.getAdminToken(ctx); err != nil {
+	token, err := c.getAdminToken(ctx)
+	if err != nil {
 		return err
 	}
 
@@ -72,9 +108,9 @@ func (c *Client) CreateUser(ctx context.Context, name, email, password string) e
 		},
 	}
 
-	_, err := c.client.R().
+	_, err = c.client.R().
 		SetContext(ctx).
-		SetHeader("Authorization", "Bearer "+c.token).
+		SetHeader("Authorization", "Bearer "+token).
 		SetHeader("Content-Type", "application/json").
 		SetBody(user).
 		Post(fmt.Sprintf("%s/admin/realms/%s/users", c.baseURL, c.realm))
@@ -82,29 +118,141 @@ func (c *Client) CreateUser(ctx context.Context, name, email, password string) e
 	return err
 }
 
-func (c *Client) Login(ctx context.Context, email, password string) (string, error) {
+// ErrInvalidGrant is returned when Keycloak refuses the credentials or refresh
+// token of a grant.
+var ErrInvalidGrant = errors.New("invalid grant")
+
+// Token is the token set Keycloak issues for a grant. The expiries are in
+// seconds.
+type Token struct {
+	AccessToken      string `json:"access_token"`
+	ExpiresIn        int64  `json:"expires_in"`
+	RefreshToken     string `json:"refresh_token"`
+	RefreshExpiresIn int64  `json:"refresh_expires_in"`
+	TokenType        string `json:"token_type"`
+}
+
+// oauthError is the body of a refused OpenID Connect request.
+type oauthError struct {
+	Error       string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (c *Client) Login(ctx context.Context, email, password string) (*Token, error) {
+	token, err := c.grant(ctx, map[string]string{
+		"grant_type": "password",
+		"username":   email,
+		"password":   password,
+	})
+	if errors.Is(err, ErrInvalidGrant) {
+		return nil, fmt.Errorf("%w: invalid credentials", ErrInvalidGrant)
+	}
+	return token, err
+}
+
+// Refresh exchanges a refresh token for a new token set.
+func (c *Client) Refresh(ctx context.Context, refreshToken string) (*Token, error) {
+	return c.grant(ctx, map[string]string{
+		"grant_type":    "refresh_token",
+		"refresh_token": refreshToken,
+	})
+}
+
+// Logout ends the Keycloak session of the refresh token, which revokes every
+// refresh token issued for it.
+func (c *Client) Logout(ctx context.Context, refreshToken string) error {
+	var oauthErr oauthError
 	resp, err := c.client.R().
 		SetContext(ctx).
 		SetFormData(
 			map[string]string{
-				"grant_type": "password",
-				"client_id":  os.Getenv("KEYCLOAK_CLIENT_ID"),
-				"username":   email,
-				"password":   password,
+				"client_id":     os.Getenv("KEYCLOAK_CLIENT_ID"),
+				"refresh_token": refreshToken,
 			},
 		).
 		SetHeader("Content-Type", "application/x-www-form-urlencoded").
-		SetResult(map[string]interface{}{}).
+		SetError(&oauthErr).
+		Post(fmt.Sprintf("%s/realms/%s/protocol/openid-connect/logout", c.baseURL, c.realm))
+	if err != nil {
+		return err
+	}
+	return refused(resp, oauthErr)
+}
+
+func (c *Client) grant(ctx context.Context, form map[string]string) (*Token, error) {
+	form["client_id"] = os.Getenv("KEYCLOAK_CLIENT_ID")
+
+	var token Token
+	var oauthErr oauthError
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetFormData(form).
+		SetHeader("Content-Type", "application/x-www-form-urlencoded").
+		SetResult(&token).
+		SetError(&oauthErr).
 		Post(fmt.Sprintf("%s/realms/%s/protocol/openid-connect/token", c.baseURL, c.realm))
+	if err != nil {
+		return nil, err
+	}
+	if err := refused(resp, oauthErr); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, fmt.Errorf("token response without access token")
+	}
+	return &token, nil
+}
 
+// refused turns an error response into ErrInvalidGrant when Keycloak rejected
+// the credentials, and into a plain error otherwise.
+func refused(resp *resty.Response, oauthErr oauthError) error {
+	if !resp.IsError() {
+		return nil
+	}
+	if oauthErr.Error == "invalid_grant" {
+		if oauthErr.Description != "" {
+			return fmt.Errorf("%w: %s", ErrInvalidGrant, oauthErr.Description)
+		}
+		return ErrInvalidGrant
+	}
+	return fmt.Errorf("keycloak request failed: %s", resp.Status())
+}
+
+// FindUserID looks up a user by exact username, or by exact email when the
+// handle contains "@". found is false when no user matches.
+func (c *Client) FindUserID(ctx context.Context, handle string) (id uuid.UUID, found bool, err error) {
+	token, err := c.getAdminToken(ctx)
 	if err != nil {
-		return "", err
+		return uuid.Nil, false, err
 	}
 
-	result := resp.Result().(*map[string]interface{})
-	if token, ok := (*result)["access_token"].(string); ok {
-		return token, nil
+	field := "username"
+	if strings.Contains(handle, "@") {
+		field = "email"
+	}
+
+	var users []struct {
+		ID string `json:"id"`
+	}
+	resp, err := c.client.R().
+		SetContext(ctx).
+		SetHeader("Authorization", "Bearer "+token).
+		SetQueryParams(map[string]string{field: handle, "exact": "true"}).
+		SetResult(&users).
+		Get(fmt.Sprintf("%s/admin/realms/%s/users", c.baseURL, c.realm))
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	if resp.IsError() {
+		return uuid.Nil, false, fmt.Errorf("user lookup failed: %s", resp.Status())
+	}
+	if len(users) == 0 {
+		return uuid.Nil, false, nil
 	}
 
-	return "", fmt.Errorf("invalid credentials")
+	id, err = uuid.Parse(users[0].ID)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	return id, true, nil
 }
@@ -0,0 +1,100 @@
+package keycloak_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"task-manager/pkg/keycloak"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_RefreshAndLogout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /realms/task-manager/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.FormValue("grant_type") != "refresh_token" || r.FormValue("refresh_token") != "valid" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Token is not active"}`))
+			return
+		}
+		assert.Equal(t, "task-client", r.FormValue("client_id"))
+		_, _ = w.Write([]byte(`{"access_token":"access","expires_in":300,"refresh_token":"rotated","refresh_expires_in":1800,"token_type":"Bearer"}`))
+	})
+	mux.HandleFunc("POST /realms/task-manager/protocol/openid-connect/logout", func(w http.ResponseWriter, r *http.Request) {
+		if r.FormValue("refresh_token") != "valid" {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant","error_description":"Invalid refresh token"}`))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	t.Setenv("KEYCLOAK_BASE_URL", srv.URL)
+	t.Setenv("KEYCLOAK_REALM", "task-manager")
+	t.Setenv("KEYCLOAK_CLIENT_ID", "task-client")
+	kc := keycloak.NewClient()
+	ctx := context.Background()
+
+	token, err := kc.Refresh(ctx, "valid")
+	require.NoError(t, err)
+	assert.Equal(t, keycloak.Token{
+		AccessToken:      "access",
+		ExpiresIn:        300,
+		RefreshToken:     "rotated",
+		RefreshExpiresIn: 1800,
+		TokenType:        "Bearer",
+	}, *token)
+
+	_, err = kc.Refresh(ctx, "expired")
+	assert.ErrorIs(t, err, keycloak.ErrInvalidGrant)
+
+	assert.NoError(t, kc.Logout(ctx, "valid"))
+	assert.ErrorIs(t, kc.Logout(ctx, "expired"), keycloak.ErrInvalidGrant)
+}
+
+func TestClient_FindUserID_ReusesAdminToken(t *testing.T) {
+	var grants atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST /realms/task-manager/protocol/openid-connect/token", func(w http.ResponseWriter, r *http.Request) {
+		grants.Add(1)
+		assert.Equal(t, "client_credentials", r.FormValue("grant_type"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"admin","expires_in":300}`))
+	})
+	mux.HandleFunc("GET /admin/realms/task-manager/users", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer admin", r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id":"7c1b5c55-4d43-4bd6-9c6b-8e2f0a1d2b3c"}]`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	t.Setenv("KEYCLOAK_BASE_URL", srv.URL)
+	t.Setenv("KEYCLOAK_REALM", "task-manager")
+	t.Setenv("KEYCLOAK_ADMIN_CLIENT_ID", "task-manager-admin")
+	t.Setenv("KEYCLOAK_ADMIN_CLIENT_SECRET", "secret")
+	kc := keycloak.NewClient()
+
+	var wg sync.WaitGroup
+	for _, handle := range []string{"alice", "bob@example.com", "carol", "dave"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, found, err := kc.FindUserID(context.Background(), handle)
+			assert.NoError(t, err)
+			assert.True(t, found)
+		}()
+	}
+	wg.Wait()
+
+	assert.EqualValues(t, 1, grants.Load())
+}
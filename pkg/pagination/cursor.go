@@ -0,0 +1,68 @@
+// Package pagination implements opaque keyset cursors for list endpoints.
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultLimit = 20
+	MaxLimit     = 100
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Request asks for one page of a list.
+type Request struct {
+	// Limit is clamped by Limit; zero means DefaultLimit.
+	Limit int
+	// Cursor is the next cursor of the previous page, empty for the first page.
+	Cursor string
+}
+
+// Cursor points just past the last item of a page ordered by (Key, ID).
+type Cursor struct {
+	// Sort identifies the ordering the cursor was issued for.
+	Sort string `json:"s,omitempty"`
+	// Key is the sort value of the last item, formatted by the caller; nil stands for NULL.
+	Key *string   `json:"k,omitempty"`
+	ID  uuid.UUID `json:"id"`
+}
+
+// Encode returns the opaque form of the cursor handed to clients.
+func (c Cursor) Encode() string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decode parses a cursor produced by Encode. An empty string yields nil.
+func Decode(s string) (*Cursor, error) {
+	if s == "" {
+		return nil, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	var c Cursor
+	if err := json.Unmarshal(raw, &c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	return &c, nil
+}
+
+// Limit clamps a requested page size to [1, MaxLimit], using DefaultLimit for zero.
+func Limit(requested int) int {
+	switch {
+	case requested <= 0:
+		return DefaultLimit
+	case requested > MaxLimit:
+		return MaxLimit
+	default:
+		return requested
+	}
+}
@@ -0,0 +1,410 @@
+// Package rrule parses and expands the subset of RFC 5545 recurrence rules
+// used for recurring tasks: FREQ (DAILY, WEEKLY, MONTHLY, YEARLY), INTERVAL,
+// COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST=MO.
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Frequency int
+
+const (
+	Daily Frequency = iota + 1
+	Weekly
+	Monthly
+	Yearly
+)
+
+var frequencyNames = map[Frequency]string{
+	Daily:   "DAILY",
+	Weekly:  "WEEKLY",
+	Monthly: "MONTHLY",
+	Yearly:  "YEARLY",
+}
+
+// WeekdayNum is a BYDAY entry. N is the ordinal within the month, such as 1
+// for the first or -1 for the last; 0 means every such weekday.
+type WeekdayNum struct {
+	N   int
+	Day time.Weekday
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Rule is a parsed recurrence rule.
+type Rule struct {
+	Freq     Frequency
+	Interval int
+	// Count is the number of occurrences, 0 for no limit.
+	Count int
+	// Until is the last possible occurrence, zero for no limit.
+	Until      time.Time
+	ByDay      []WeekdayNum
+	ByMonthDay []int
+	ByMonth    []time.Month
+}
+
+// maxPeriods bounds how far Next looks for an occurrence, so that rules that
+// can never match, such as the 30th of February, terminate.
+const maxPeriods = 50000
+
+var ErrInvalid = errors.New("invalid recurrence rule")
+
+// Parse reads a rule such as "FREQ=WEEKLY;BYDAY=MO,TH". A leading "RRULE:"
+// is accepted. UNTIL values without a zone are taken as UTC.
+func Parse(s string) (*Rule, error) {
+	s = strings.TrimPrefix(strings.TrimSpace(s), "RRULE:")
+	if s == "" {
+		return nil, fmt.Errorf("%w: empty rule", ErrInvalid)
+	}
+
+	r := &Rule{Interval: 1}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(s, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		value = strings.ToUpper(strings.TrimSpace(value))
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalid, part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: %s given twice", ErrInvalid, key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			err = r.parseFreq(value)
+		case "INTERVAL":
+			r.Interval, err = parsePositive(value)
+		case "COUNT":
+			r.Count, err = parsePositive(value)
+		case "UNTIL":
+			r.Until, err = parseUntil(value)
+		case "BYDAY":
+			r.ByDay, err = parseByDay(value)
+		case "BYMONTHDAY":
+			r.ByMonthDay, err = parseByMonthDay(value)
+		case "BYMONTH":
+			r.ByMonth, err = parseByMonth(value)
+		case "WKST":
+			if value != "MO" {
+				err = errors.New("only WKST=MO is supported")
+			}
+		default:
+			err = fmt.Errorf("%s is not supported", key)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+		}
+	}
+
+	if err := r.validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return r, nil
+}
+
+func (r *Rule) parseFreq(value string) error {
+	for f, name := range frequencyNames {
+		if name == value {
+			r.Freq = f
+			return nil
+		}
+	}
+	return fmt.Errorf("FREQ=%s is not supported", value)
+}
+
+func (r *Rule) validate() error {
+	if r.Freq == 0 {
+		return errors.New("FREQ is required")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return errors.New("COUNT and UNTIL are mutually exclusive")
+	}
+	for _, d := range r.ByDay {
+		if d.N != 0 && r.Freq != Monthly {
+			return errors.New("BYDAY ordinals are only supported with FREQ=MONTHLY")
+		}
+	}
+	if len(r.ByMonthDay) > 0 && (r.Freq == Daily || r.Freq == Weekly) {
+		return errors.New("BYMONTHDAY is only supported with FREQ=MONTHLY or FREQ=YEARLY")
+	}
+	return nil
+}
+
+func parsePositive(value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("%q is not a positive number", value)
+	}
+	return n, nil
+}
+
+func parseUntil(value string) (time.Time, error) {
+	for _, layout := range []string{"20060102T150405Z", "20060102T150405", "20060102"} {
+		if t, err := time.Parse(layout, value); err == nil {
+			if layout == "20060102" {
+				// A date includes the whole day.
+				t = t.Add(24*time.Hour - time.Second)
+			}
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("UNTIL=%s is not a date or date-time", value)
+}
+
+func parseByDay(value string) ([]WeekdayNum, error) {
+	var days []WeekdayNum
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("BYDAY entry %q is malformed", item)
+		}
+		day, ok := weekdayNames[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("BYDAY entry %q is not a weekday", item)
+		}
+		n := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			var err error
+			n, err = strconv.Atoi(prefix)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("BYDAY ordinal %q must be between -5 and 5", prefix)
+			}
+		}
+		days = append(days, WeekdayNum{N: n, Day: day})
+	}
+	return days, nil
+}
+
+func parseByMonthDay(value string) ([]int, error) {
+	var days []int
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n == 0 || n < -31 || n > 31 {
+			return nil, fmt.Errorf("BYMONTHDAY entry %q must be between -31 and 31", item)
+		}
+		days = append(days, n)
+	}
+	return days, nil
+}
+
+func parseByMonth(value string) ([]time.Month, error) {
+	var months []time.Month
+	for _, item := range strings.Split(value, ",") {
+		n, err := strconv.Atoi(item)
+		if err != nil || n < 1 || n > 12 {
+			return nil, fmt.Errorf("BYMONTH entry %q must be between 1 and 12", item)
+		}
+		months = append(months, time.Month(n))
+	}
+	return months, nil
+}
+
+// String renders the rule in canonical form.
+func (r *Rule) String() string {
+	parts := []string{"FREQ=" + frequencyNames[r.Freq]}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+	if len(r.ByDay) > 0 {
+		items := make([]string, 0, len(r.ByDay))
+		for _, d := range r.ByDay {
+			item := strings.ToUpper(d.Day.String()[:2])
+			if d.N != 0 {
+				item = strconv.Itoa(d.N) + item
+			}
+			items = append(items, item)
+		}
+		parts = append(parts, "BYDAY="+strings.Join(items, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		items := make([]string, 0, len(r.ByMonthDay))
+		for _, d := range r.ByMonthDay {
+			items = append(items, strconv.Itoa(d))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(items, ","))
+	}
+	if len(r.ByMonth) > 0 {
+		items := make([]string, 0, len(r.ByMonth))
+		for _, m := range r.ByMonth {
+			items = append(items, strconv.Itoa(int(m)))
+		}
+		parts = append(parts, "BYMONTH="+strings.Join(items, ","))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Next returns the first occurrence of the series starting at dtstart that
+// falls strictly after after. ok is false once the series is over. Dates are
+// expanded in the location of dtstart, keeping its time of day.
+func (r *Rule) Next(dtstart, after time.Time) (next time.Time, ok bool) {
+	count := 0
+	for period := 0; period < maxPeriods; period++ {
+		for _, t := range r.expand(dtstart, period) {
+			if t.Before(dtstart) {
+				continue
+			}
+			if !r.Until.IsZero() && t.After(r.Until) {
+				return time.Time{}, false
+			}
+			count++
+			if r.Count > 0 && count > r.Count {
+				return time.Time{}, false
+			}
+			if t.After(after) {
+				return t, true
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+// expand lists the candidates of the n-th period of the rule in order.
+func (r *Rule) expand(dtstart time.Time, n int) []time.Time {
+	step := n * r.Interval
+	y, m, d := dtstart.Date()
+	loc := dtstart.Location()
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, loc)
+	}
+
+	var days []time.Time
+	switch r.Freq {
+	case Daily:
+		days = []time.Time{at(y, m, d+step)}
+	case Weekly:
+		// Weeks start on Monday.
+		monday := at(y, m, d-(int(dtstart.Weekday())+6)%7+7*step)
+		if len(r.ByDay) == 0 {
+			days = []time.Time{monday.AddDate(0, 0, (int(dtstart.Weekday())+6)%7)}
+			break
+		}
+		for _, wd := range r.ByDay {
+			days = append(days, monday.AddDate(0, 0, (int(wd.Day)+6)%7))
+		}
+	case Monthly:
+		first := at(y, m+time.Month(step), 1)
+		days = r.monthDays(first, d)
+	case Yearly:
+		months := r.ByMonth
+		if len(months) == 0 {
+			if len(r.ByMonthDay) > 0 || len(r.ByDay) > 0 {
+				months = []time.Month{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else {
+				months = []time.Month{m}
+			}
+		}
+		for _, month := range months {
+			days = append(days, r.monthDays(at(y+step, month, 1), d)...)
+		}
+	}
+
+	out := days[:0]
+	for _, t := range days {
+		if r.matches(t) {
+			out = append(out, t)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Before(out[j]) })
+	return dedupe(out)
+}
+
+// monthDays lists the days of the month starting at first that the rule
+// selects, defaulting to the day of month of dtstart.
+func (r *Rule) monthDays(first time.Time, defaultDay int) []time.Time {
+	length := first.AddDate(0, 1, -1).Day()
+	var days []time.Time
+
+	switch {
+	case len(r.ByMonthDay) > 0:
+		for _, md := range r.ByMonthDay {
+			day := md
+			if md < 0 {
+				day = length + md + 1
+			}
+			if day >= 1 && day <= length {
+				days = append(days, first.AddDate(0, 0, day-1))
+			}
+		}
+	case len(r.ByDay) > 0:
+		for _, wd := range r.ByDay {
+			offset := (int(wd.Day) - int(first.Weekday()) + 7) % 7
+			var all []time.Time
+			for day := 1 + offset; day <= length; day += 7 {
+				all = append(all, first.AddDate(0, 0, day-1))
+			}
+			switch {
+			case wd.N == 0:
+				days = append(days, all...)
+			case wd.N > 0 && wd.N <= len(all):
+				days = append(days, all[wd.N-1])
+			case wd.N < 0 && -wd.N <= len(all):
+				days = append(days, all[len(all)+wd.N])
+			}
+		}
+	default:
+		// Months without the day, such as February for the 30th, are skipped.
+		if defaultDay <= length {
+			days = append(days, first.AddDate(0, 0, defaultDay-1))
+		}
+	}
+	return days
+}
+
+// matches applies the BY* parts that limit rather than expand the candidates.
+func (r *Rule) matches(t time.Time) bool {
+	if len(r.ByMonth) > 0 && r.Freq != Yearly && !containsMonth(r.ByMonth, t.Month()) {
+		return false
+	}
+	// BYDAY limits daily rules, and the days picked by BYMONTHDAY.
+	if len(r.ByDay) > 0 && (r.Freq == Daily || len(r.ByMonthDay) > 0) {
+		for _, wd := range r.ByDay {
+			if wd.Day == t.Weekday() {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func containsMonth(months []time.Month, month time.Month) bool {
+	for _, m := range months {
+		if m == month {
+			return true
+		}
+	}
+	return false
+}
+
+func dedupe(times []time.Time) []time.Time {
+	out := times[:0]
+	for i, t := range times {
+		if i == 0 || !t.Equal(times[i-1]) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
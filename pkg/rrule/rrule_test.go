@@ -0,0 +1,77 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;COUNT=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20250101",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=WEEKLY;BYMONTHDAY=1",
+		"FREQ=MONTHLY;BYSETPOS=1",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=DAILY;FREQ=WEEKLY",
+	} {
+		_, err := Parse(rule)
+		assert.ErrorIs(t, err, ErrInvalid, rule)
+	}
+}
+
+func TestParse_String(t *testing.T) {
+	r, err := Parse("RRULE:freq=monthly;interval=2;byday=-1fr;count=4")
+	require.NoError(t, err)
+	assert.Equal(t, "FREQ=MONTHLY;INTERVAL=2;COUNT=4;BYDAY=-1FR", r.String())
+}
+
+func TestRule_Next(t *testing.T) {
+	// Wednesday
+	dtstart := time.Date(2025, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		rule string
+		want []string
+	}{
+		{"FREQ=DAILY;INTERVAL=2;COUNT=3", []string{"2025-01-17", "2025-01-19"}},
+		{"FREQ=WEEKLY;BYDAY=MO,FR", []string{"2025-01-17", "2025-01-20", "2025-01-24"}},
+		{"FREQ=WEEKLY;INTERVAL=2", []string{"2025-01-29", "2025-02-12", "2025-02-26"}},
+		{"FREQ=MONTHLY", []string{"2025-02-15", "2025-03-15", "2025-04-15"}},
+		{"FREQ=MONTHLY;BYDAY=-1FR", []string{"2025-01-31", "2025-02-28", "2025-03-28"}},
+		{"FREQ=MONTHLY;BYMONTHDAY=-1;UNTIL=20250331", []string{"2025-01-31", "2025-02-28", "2025-03-31"}},
+		{"FREQ=YEARLY;BYMONTH=3,9", []string{"2025-03-15", "2025-09-15", "2026-03-15"}},
+		{"FREQ=DAILY;BYDAY=SA,SU", []string{"2025-01-18", "2025-01-19", "2025-01-25"}},
+	}
+	for _, tt := range tests {
+		r, err := Parse(tt.rule)
+		require.NoError(t, err, tt.rule)
+
+		var got []string
+		after := dtstart
+		for i := 0; i < 3; i++ {
+			next, ok := r.Next(dtstart, after)
+			if !ok {
+				break
+			}
+			assert.Equal(t, 9, next.Hour(), tt.rule)
+			got = append(got, next.Format("2006-01-02"))
+			after = next
+		}
+		assert.Equal(t, tt.want, got, tt.rule)
+	}
+}
+
+func TestRule_Next_Impossible(t *testing.T) {
+	r, err := Parse("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30")
+	require.NoError(t, err)
+
+	_, ok := r.Next(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.False(t, ok)
+}
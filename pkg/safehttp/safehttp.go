@@ -0,0 +1,116 @@
+// Package safehttp sends requests to URLs that users supply, such as webhook
+// subscriptions, without letting those URLs reach into the server's own
+// network.
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrBlockedAddress is returned for URLs and connections that point at a
+// loopback, private, link-local or unspecified address.
+var ErrBlockedAddress = errors.New("address not allowed")
+
+// sharedAddressSpace is the carrier-grade NAT range, which netip does not
+// count as private.
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// Policy decides which addresses user-supplied URLs may reach.
+type Policy struct {
+	// AllowPrivateNetworks lifts the address checks, for development setups
+	// whose receivers run next to the server.
+	AllowPrivateNetworks bool
+}
+
+// PolicyFromEnv reads WEBHOOK_ALLOW_PRIVATE_NETWORKS, false by default.
+func PolicyFromEnv() (Policy, error) {
+	var p Policy
+	if raw := os.Getenv("WEBHOOK_ALLOW_PRIVATE_NETWORKS"); raw != "" {
+		allow, err := strconv.ParseBool(raw)
+		if err != nil {
+			return Policy{}, fmt.Errorf("invalid WEBHOOK_ALLOW_PRIVATE_NETWORKS: %q", raw)
+		}
+		p.AllowPrivateNetworks = allow
+	}
+	return p, nil
+}
+
+// CheckURL returns an error unless raw is an absolute http(s) URL. Hosts
+// that are blocked addresses or localhost names are refused here already;
+// names that resolve to blocked addresses are refused when connecting.
+func (p Policy) CheckURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("must be an absolute http(s) URL")
+	}
+	if p.AllowPrivateNetworks {
+		return nil
+	}
+
+	host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && blocked(addr) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, host)
+	}
+	return nil
+}
+
+// NewClient returns a client that never follows redirects and, unless the
+// policy allows private networks, only connects to public addresses. The
+// check runs on the address actually dialled, so DNS answers that change
+// after CheckURL cannot get around it.
+func (p Policy) NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !p.AllowPrivateNetworks {
+		dialer.Control = refuseBlocked
+	}
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			// A proxy would make the dialled address the proxy's.
+			Proxy:               nil,
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: timeout,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func refuseBlocked(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if blocked(addr) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, addr)
+	}
+	return nil
+}
+
+func blocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() ||
+		addr.IsInterfaceLocalMulticast() || addr.IsMulticast() ||
+		sharedAddressSpace.Contains(addr)
+}
@@ -0,0 +1,57 @@
+package safehttp_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"task-manager/pkg/safehttp"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_CheckURL(t *testing.T) {
+	var p safehttp.Policy
+
+	for raw, ok := range map[string]bool{
+		"https://hooks.example.com/task-manager": true,
+		"http://203.0.113.7:8080/hook":           true,
+		"ftp://hooks.example.com":                false,
+		"/relative":                              false,
+		"http://localhost:8080/hook":             false,
+		"http://127.0.0.1/hook":                  false,
+		"http://10.0.0.5/hook":                   false,
+		"http://169.254.169.254/latest":          false,
+		"http://[::1]/hook":                      false,
+		"http://[::ffff:192.168.1.1]/hook":       false,
+		"http://0.0.0.0/hook":                    false,
+	} {
+		assert.Equal(t, ok, p.CheckURL(raw) == nil, raw)
+	}
+	assert.NoError(t, safehttp.Policy{AllowPrivateNetworks: true}.CheckURL("http://localhost:8080/hook"))
+}
+
+func TestPolicy_NewClient(t *testing.T) {
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusFound))
+	defer redirect.Close()
+
+	_, err := safehttp.Policy{}.NewClient(time.Second).Get(target.URL)
+	assert.ErrorIs(t, err, safehttp.ErrBlockedAddress)
+
+	client := safehttp.Policy{AllowPrivateNetworks: true}.NewClient(time.Second)
+	resp, err := client.Get(target.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp, err = client.Get(redirect.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusFound, resp.StatusCode)
+}
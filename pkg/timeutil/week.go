@@ -0,0 +1,11 @@
+package timeutil
+
+import "time"
+
+// WeekRange returns the start of the ISO week (Monday 00:00) containing t and
+// the start of the following week, both in t's location.
+func WeekRange(t time.Time) (time.Time, time.Time) {
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, t.Location())
+	return start, start.AddDate(0, 0, 7)
+}
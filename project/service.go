@@ -2,24 +2,282 @@ package project
 
 import (
 	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
 
+	"task-manager/authz"
 	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	"task-manager/pkg/timeutil"
 
 	"github.com/google/uuid"
 )
 
+type Repository interface {
+	// Create stores a project together with its owner's membership.
+	Create(ctx context.Context, project *domain.Project, owner domain.ProjectMember) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Project, error)
+	ListByMember(ctx context.Context, userID uuid.UUID, includeArchived bool) ([]domain.Project, error)
+	Update(ctx context.Context, project *domain.Project) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// SaveMember adds a member or changes the role of an existing one.
+	SaveMember(ctx context.Context, member *domain.ProjectMember) error
+	ListMembers(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error)
+	RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error
+}
+
 type TaskRepository interface {
-	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListByProject(
+		ctx context.Context,
+		projectID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListBlockedByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListTrashedByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error)
+	ListOverdueByProject(ctx context.Context, projectID uuid.UUID, now time.Time) ([]domain.Task, error)
+	ListDueBetweenByProject(ctx context.Context, projectID uuid.UUID, from, to time.Time) ([]domain.Task, error)
 }
 
 type Service struct {
+	repo           Repository
 	taskRepository TaskRepository
+	authorizer     authz.Authorizer
+}
+
+func NewService(repo Repository, taskRepository TaskRepository, authorizer authz.Authorizer) *Service {
+	return &Service{repo: repo, taskRepository: taskRepository, authorizer: authorizer}
+}
+
+var keyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]{1,9}$`)
+
+// Create creates a project owned by ownerID, who becomes its first member.
+// The key is stored in upper case.
+func (s Service) Create(
+	ctx context.Context,
+	ownerID uuid.UUID,
+	name, key, description string,
+) (*domain.Project, error) {
+	now := time.Now()
+	p := &domain.Project{
+		ID:          uuid.New(),
+		Name:        strings.TrimSpace(name),
+		Key:         strings.ToUpper(strings.TrimSpace(key)),
+		Description: strings.TrimSpace(description),
+		OwnerID:     ownerID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := validate(p); err != nil {
+		return nil, err
+	}
+
+	owner := domain.ProjectMember{ProjectID: p.ID, UserID: ownerID, Role: domain.ProjectRoleOwner, CreatedAt: now}
+	if err := s.repo.Create(ctx, p, owner); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (s Service) GetByID(ctx context.Context, id uuid.UUID) (*domain.Project, error) {
+	if err := s.authorizer.Require(ctx, id, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
+}
+
+// ListForMember lists the projects the user is a member of, by name.
+// Archived projects are only included when includeArchived is set.
+func (s Service) ListForMember(
+	ctx context.Context,
+	userID uuid.UUID,
+	includeArchived bool,
+) ([]domain.Project, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByMember(ctx, userID, includeArchived)
+}
+
+// Update renames, describes and/or archives a project; nil arguments are
+// left unchanged. The key cannot change.
+func (s Service) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	name, description *string,
+	archived *bool,
+) (*domain.Project, error) {
+	if err := s.authorizer.Require(ctx, id, domain.PermissionManageProject); err != nil {
+		return nil, err
+	}
+	p, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if name != nil {
+		p.Name = strings.TrimSpace(*name)
+	}
+	if description != nil {
+		p.Description = strings.TrimSpace(*description)
+	}
+	if archived != nil {
+		p.Archived = *archived
+	}
+	if err := validate(p); err != nil {
+		return nil, err
+	}
+
+	p.UpdatedAt = time.Now()
+	if err := s.repo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Delete removes a project that has no tasks left, trashed ones included.
+func (s Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := s.authorizer.Require(ctx, id, domain.PermissionManageProject); err != nil {
+		return err
+	}
+	if _, err := s.repo.GetByID(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+func (s Service) ListMembers(ctx context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	if _, err := s.repo.GetByID(ctx, projectID); err != nil {
+		return nil, err
+	}
+	return s.repo.ListMembers(ctx, projectID)
+}
+
+// AddMember adds a user to a project, or changes the role of a member. The
+// role defaults to member; ownership cannot be granted this way.
+func (s Service) AddMember(
+	ctx context.Context,
+	projectID, userID uuid.UUID,
+	role domain.ProjectRole,
+) (*domain.ProjectMember, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionManageMembers); err != nil {
+		return nil, err
+	}
+	p, err := s.repo.GetByID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if userID == uuid.Nil {
+		return nil, fmt.Errorf("%w: user_id is required", domain.ErrInvalidProject)
+	}
+	if role == "" {
+		role = domain.ProjectRoleMember
+	}
+	if !role.Valid() || role == domain.ProjectRoleOwner {
+		return nil, fmt.Errorf("%w: unknown role %q", domain.ErrInvalidProject, role)
+	}
+	if userID == p.OwnerID {
+		return nil, fmt.Errorf("%w: the owner's role cannot change", domain.ErrInvalidProject)
+	}
+
+	member := &domain.ProjectMember{ProjectID: projectID, UserID: userID, Role: role, CreatedAt: time.Now()}
+	if err := s.repo.SaveMember(ctx, member); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+// RemoveMember takes a user out of a project. The owner cannot be removed.
+func (s Service) RemoveMember(ctx context.Context, projectID, userID uuid.UUID) error {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionManageMembers); err != nil {
+		return err
+	}
+	p, err := s.repo.GetByID(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if userID == p.OwnerID {
+		return fmt.Errorf("%w: the owner cannot be removed", domain.ErrInvalidProject)
+	}
+	return s.repo.RemoveMember(ctx, projectID, userID)
+}
+
+func validate(p *domain.Project) error {
+	if p.Name == "" {
+		return fmt.Errorf("%w: name must not be empty", domain.ErrInvalidProject)
+	}
+	if !keyPattern.MatchString(p.Key) {
+		return fmt.Errorf("%w: key must be 2-10 letters or digits, starting with a letter", domain.ErrInvalidProject)
+	}
+	return nil
+}
+
+// ListTasks returns one page of matching tasks and the cursor of the next
+// page (empty on the last page).
+func (s Service) ListTasks(
+	ctx context.Context,
+	projectID uuid.UUID,
+	filter domain.TaskFilter,
+	sort domain.TaskSort,
+	page pagination.Request,
+) ([]domain.Task, string, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, "", err
+	}
+	return s.taskRepository.ListByProject(ctx, projectID, filter, sort, page)
+}
+
+// ListTrashedTasks lists the tasks of the project that are in the trash.
+func (s Service) ListTrashedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.taskRepository.ListTrashedByProject(ctx, projectID)
+}
+
+// ListBlockedTasks lists uncompleted tasks of the project that still wait on an open blocker.
+func (s Service) ListBlockedTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.taskRepository.ListBlockedByProject(ctx, projectID)
+}
+
+// ListOverdueTasks lists uncompleted tasks of the project whose due date has passed.
+func (s Service) ListOverdueTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.taskRepository.ListOverdueByProject(ctx, projectID, time.Now())
 }
 
-func NewService(taskRepository TaskRepository) *Service {
-	return &Service{taskRepository: taskRepository}
+// ListTasksDueThisWeek lists uncompleted tasks of the project that are due in
+// the current week, as observed in loc.
+func (s Service) ListTasksDueThisWeek(
+	ctx context.Context,
+	projectID uuid.UUID,
+	loc *time.Location,
+) ([]domain.Task, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	from, to := timeutil.WeekRange(time.Now().In(loc))
+	return s.taskRepository.ListDueBetweenByProject(ctx, projectID, from, to)
 }
 
-func (s Service) ListTasks(ctx context.Context, projectID uuid.UUID) ([]domain.Task, error) {
-	return s.taskRepository.ListByProject(ctx, projectID)
+// ListTasksDueBetween lists uncompleted tasks of the project that are due in [from, to).
+func (s Service) ListTasksDueBetween(
+	ctx context.Context,
+	projectID uuid.UUID,
+	from, to time.Time,
+) ([]domain.Task, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.taskRepository.ListDueBetweenByProject(ctx, projectID, from, to)
 }
@@ -0,0 +1,124 @@
+package project_test
+
+import (
+	"context"
+	"testing"
+
+	"task-manager/authz"
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/project"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryProjects keeps projects and their members in memory.
+type memoryProjects struct {
+	project.Repository
+	projects map[uuid.UUID]domain.Project
+	members  map[[2]uuid.UUID]domain.ProjectMember
+}
+
+func newMemoryProjects() *memoryProjects {
+	return &memoryProjects{
+		projects: make(map[uuid.UUID]domain.Project),
+		members:  make(map[[2]uuid.UUID]domain.ProjectMember),
+	}
+}
+
+func (m *memoryProjects) Create(_ context.Context, p *domain.Project, owner domain.ProjectMember) error {
+	m.projects[p.ID] = *p
+	m.members[[2]uuid.UUID{owner.ProjectID, owner.UserID}] = owner
+	return nil
+}
+
+func (m *memoryProjects) GetByID(_ context.Context, id uuid.UUID) (*domain.Project, error) {
+	p, ok := m.projects[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &p, nil
+}
+
+func (m *memoryProjects) SaveMember(_ context.Context, member *domain.ProjectMember) error {
+	m.members[[2]uuid.UUID{member.ProjectID, member.UserID}] = *member
+	return nil
+}
+
+func (m *memoryProjects) GetMember(_ context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	member, ok := m.members[[2]uuid.UUID{projectID, userID}]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &member, nil
+}
+
+func (m *memoryProjects) ListMembers(_ context.Context, projectID uuid.UUID) ([]domain.ProjectMember, error) {
+	var members []domain.ProjectMember
+	for key, member := range m.members {
+		if key[0] == projectID {
+			members = append(members, member)
+		}
+	}
+	return members, nil
+}
+
+func (m *memoryProjects) RemoveMember(_ context.Context, projectID, userID uuid.UUID) error {
+	delete(m.members, [2]uuid.UUID{projectID, userID})
+	return nil
+}
+
+func TestService_Create(t *testing.T) {
+	repo := newMemoryProjects()
+	svc := project.NewService(repo, nil, authz.NewRoleAuthorizer(repo))
+	ownerID := uuid.New()
+
+	p, err := svc.Create(context.Background(), ownerID, "  Website ", "web", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Website", p.Name)
+	assert.Equal(t, "WEB", p.Key)
+	assert.Equal(t, ownerID, p.OwnerID)
+	assert.Equal(t, domain.ProjectRoleOwner, repo.members[[2]uuid.UUID{p.ID, ownerID}].Role)
+
+	for _, key := range []string{"", "W", "1WEB", "WEB-1", "WEBSITE2025"} {
+		_, err := svc.Create(context.Background(), ownerID, "Website", key, "")
+		assert.ErrorIs(t, err, domain.ErrInvalidProject, key)
+	}
+	_, err = svc.Create(context.Background(), ownerID, " ", "WEB", "")
+	assert.ErrorIs(t, err, domain.ErrInvalidProject)
+}
+
+func TestService_Members(t *testing.T) {
+	repo := newMemoryProjects()
+	svc := project.NewService(repo, nil, authz.NewRoleAuthorizer(repo))
+	ownerID, userID := uuid.New(), uuid.New()
+	asOwner := authctx.WithUserID(context.Background(), ownerID)
+	p, err := svc.Create(asOwner, ownerID, "Website", "WEB", "")
+	require.NoError(t, err)
+
+	member, err := svc.AddMember(asOwner, p.ID, userID, "")
+	require.NoError(t, err)
+	assert.Equal(t, domain.ProjectRoleMember, member.Role)
+	assert.Contains(t, repo.members, [2]uuid.UUID{p.ID, userID})
+
+	_, err = svc.AddMember(asOwner, p.ID, uuid.New(), domain.ProjectRoleOwner)
+	assert.ErrorIs(t, err, domain.ErrInvalidProject)
+	_, err = svc.AddMember(asOwner, p.ID, ownerID, domain.ProjectRoleMember)
+	assert.ErrorIs(t, err, domain.ErrInvalidProject)
+	_, err = svc.AddMember(asOwner, uuid.New(), userID, "")
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	// Members work in the project but do not manage who else does.
+	asMember := authctx.WithUserID(context.Background(), userID)
+	_, err = svc.ListMembers(asMember, p.ID)
+	assert.NoError(t, err)
+	_, err = svc.AddMember(asMember, p.ID, uuid.New(), domain.ProjectRoleViewer)
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+
+	assert.ErrorIs(t, svc.RemoveMember(asOwner, p.ID, ownerID), domain.ErrInvalidProject)
+	require.NoError(t, svc.RemoveMember(asOwner, p.ID, userID))
+	assert.NotContains(t, repo.members, [2]uuid.UUID{p.ID, userID})
+}
@@ -0,0 +1,147 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "task-manager/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// SeriesRepository is an autogenerated mock type for the SeriesRepository type
+type SeriesRepository struct {
+	mock.Mock
+}
+
+// Advance provides a mock function with given fields: ctx, id, from, taskID, at, next
+func (_m *SeriesRepository) Advance(ctx context.Context, id uuid.UUID, from time.Time, taskID uuid.UUID, at time.Time, next *time.Time) error {
+	ret := _m.Called(ctx, id, from, taskID, at, next)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Advance")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time, uuid.UUID, time.Time, *time.Time) error); ok {
+		r0 = rf(ctx, id, from, taskID, at, next)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Create provides a mock function with given fields: ctx, series
+func (_m *SeriesRepository) Create(ctx context.Context, series *domain.Series) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Series) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *SeriesRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Series, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Series, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Series); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListDue provides a mock function with given fields: ctx, now
+func (_m *SeriesRepository) ListDue(ctx context.Context, now time.Time) ([]domain.Series, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDue")
+	}
+
+	var r0 []domain.Series
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]domain.Series, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []domain.Series); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Series)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Update provides a mock function with given fields: ctx, series
+func (_m *SeriesRepository) Update(ctx context.Context, series *domain.Series) error {
+	ret := _m.Called(ctx, series)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Series) error); ok {
+		r0 = rf(ctx, series)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSeriesRepository creates a new instance of SeriesRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSeriesRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SeriesRepository {
+	mock := &SeriesRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
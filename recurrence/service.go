@@ -0,0 +1,365 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"task-manager/authz"
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/pkg/rrule"
+
+	"github.com/google/uuid"
+)
+
+type SeriesRepository interface {
+	Create(ctx context.Context, series *domain.Series) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Series, error)
+	Update(ctx context.Context, series *domain.Series) error
+	// ListDue returns the active series whose next occurrence has started, or
+	// whose latest occurrence has been completed.
+	ListDue(ctx context.Context, now time.Time) ([]domain.Series, error)
+	// Advance moves a series on to a new occurrence, returning
+	// domain.ErrConflict when its next occurrence is no longer from.
+	Advance(ctx context.Context, id uuid.UUID, from time.Time, taskID uuid.UUID, at time.Time, next *time.Time) error
+}
+
+type TaskRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+	ListOpenBySeries(ctx context.Context, seriesID uuid.UUID) ([]domain.Task, error)
+	SetSeries(ctx context.Context, taskID, seriesID uuid.UUID) error
+}
+
+// TaskService creates and changes occurrences with the usual workflow,
+// activity and mention handling.
+type TaskService interface {
+	Create(ctx context.Context, task *domain.Task) error
+	Update(ctx context.Context, task *domain.Task) error
+	AttachLabel(ctx context.Context, taskID, labelID uuid.UUID) error
+}
+
+// Transactor runs fn in a database transaction that repositories called with
+// the ctx passed to fn take part in.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
+type Service struct {
+	seriesRepo SeriesRepository
+	taskRepo   TaskRepository
+	tasks      TaskService
+	tx         Transactor
+	authorizer authz.Authorizer
+}
+
+func NewService(
+	seriesRepo SeriesRepository,
+	taskRepo TaskRepository,
+	tasks TaskService,
+	tx Transactor,
+	authorizer authz.Authorizer,
+) *Service {
+	return &Service{
+		seriesRepo: seriesRepo,
+		taskRepo:   taskRepo,
+		tasks:      tasks,
+		tx:         tx,
+		authorizer: authorizer,
+	}
+}
+
+// SetRecurrence makes a task recur by rule, expanded in timezone (UTC when
+// empty). The task becomes the first occurrence of a new series, starting at
+// its start date. When the task already belongs to an active series, the
+// rule of that series is replaced instead.
+func (s *Service) SetRecurrence(ctx context.Context, taskID uuid.UUID, rule, timezone string) (*domain.Series, error) {
+	task, err := s.readTask(ctx, taskID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+
+	r, loc, err := parseRule(rule, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	if task.SeriesID != nil {
+		series, err := s.seriesRepo.GetByID(ctx, *task.SeriesID)
+		if err != nil {
+			return nil, err
+		}
+		if series.StoppedAt == nil {
+			series.RRule = r.String()
+			series.Timezone = loc.String()
+			series.NextAt = nextAfter(r, series.Start.In(loc), series.LastAt)
+			series.UpdatedAt = time.Now()
+			if err := s.seriesRepo.Update(ctx, series); err != nil {
+				return nil, err
+			}
+			return series, nil
+		}
+		// A stopped series stays stopped; the task starts a new one.
+	}
+
+	if task.StartDate == nil {
+		return nil, fmt.Errorf("%w: a recurring task needs a start date", domain.ErrInvalidRecurrence)
+	}
+	start := task.StartDate.In(loc)
+
+	createdBy, _ := authctx.UserID(ctx)
+	now := time.Now()
+	series := &domain.Series{
+		ID:            uuid.New(),
+		ProjectID:     task.ProjectID,
+		RRule:         r.String(),
+		Timezone:      loc.String(),
+		Start:         start,
+		CurrentTaskID: task.ID,
+		LastAt:        start,
+		NextAt:        nextAfter(r, start, start),
+		CreatedBy:     createdBy,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	applyTemplate(series, task)
+
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.seriesRepo.Create(ctx, series); err != nil {
+			return err
+		}
+		return s.taskRepo.SetSeries(ctx, task.ID, series.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// Recurrence returns the series a task belongs to.
+func (s *Service) Recurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error) {
+	return s.taskSeries(ctx, taskID, domain.PermissionRead)
+}
+
+func (s *Service) taskSeries(ctx context.Context, taskID uuid.UUID, permission domain.Permission) (*domain.Series, error) {
+	task, err := s.readTask(ctx, taskID, permission)
+	if err != nil {
+		return nil, err
+	}
+	if task.SeriesID == nil {
+		return nil, fmt.Errorf("%w: task does not recur", domain.ErrNotFound)
+	}
+	return s.seriesRepo.GetByID(ctx, *task.SeriesID)
+}
+
+// StopRecurrence ends the series of a task. Existing occurrences are kept.
+func (s *Service) StopRecurrence(ctx context.Context, taskID uuid.UUID) (*domain.Series, error) {
+	series, err := s.taskSeries(ctx, taskID, domain.PermissionEdit)
+	if err != nil {
+		return nil, err
+	}
+	if series.StoppedAt != nil {
+		return series, nil
+	}
+
+	now := time.Now()
+	series.StoppedAt = &now
+	series.NextAt = nil
+	series.UpdatedAt = now
+	if err := s.seriesRepo.Update(ctx, series); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// UpdateSeries writes an occurrence like an ordinary update and then carries
+// its title, description, priority and assignee over to the series, so that
+// future occurrences use them, and to the other uncompleted occurrences.
+// Status and dates stay specific to each occurrence.
+func (s *Service) UpdateSeries(ctx context.Context, task *domain.Task) error {
+	// The series is the stored task's, whatever the request says.
+	current, err := s.readTask(ctx, task.ID, domain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+	if current.SeriesID == nil {
+		return fmt.Errorf("%w: task does not recur", domain.ErrInvalidRecurrence)
+	}
+	series, err := s.seriesRepo.GetByID(ctx, *current.SeriesID)
+	if err != nil {
+		return err
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.tasks.Update(ctx, task); err != nil {
+			return err
+		}
+
+		applyTemplate(series, task)
+		series.UpdatedAt = time.Now()
+		if err := s.seriesRepo.Update(ctx, series); err != nil {
+			return err
+		}
+
+		open, err := s.taskRepo.ListOpenBySeries(ctx, series.ID)
+		if err != nil {
+			return err
+		}
+		for i := range open {
+			occurrence := &open[i]
+			if occurrence.ID == task.ID || !differsFromTemplate(occurrence, series) {
+				continue
+			}
+			occurrence.Title = series.Title
+			occurrence.Description = series.Description
+			occurrence.Priority = series.Priority
+			occurrence.AssignedTo = series.AssignedTo
+			if err := s.tasks.Update(ctx, occurrence); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// readTask loads a task once the caller is known to hold the permission in
+// its project.
+func (s *Service) readTask(ctx context.Context, id uuid.UUID, permission domain.Permission) (*domain.Task, error) {
+	return authz.ReadTask(ctx, s.authorizer, s.taskRepo, id, permission)
+}
+
+// MaterializeDue creates the next occurrence of every series whose next
+// occurrence has started or whose latest occurrence has been completed, and
+// returns how many were created. Failures of one series do not hold up the
+// others.
+func (s *Service) MaterializeDue(ctx context.Context, now time.Time) (int, error) {
+	// Occurrences are created by the application, not by a member.
+	ctx = authctx.AsSystem(ctx)
+	due, err := s.seriesRepo.ListDue(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+
+	created := 0
+	var errs []error
+	for i := range due {
+		err := s.materialize(ctx, &due[i], now)
+		switch {
+		case err == nil:
+			created++
+		case errors.Is(err, domain.ErrConflict):
+			// Another scheduler run created it.
+		default:
+			errs = append(errs, fmt.Errorf("series %s: %w", due[i].ID, err))
+		}
+	}
+	return created, errors.Join(errs...)
+}
+
+func (s *Service) materialize(ctx context.Context, series *domain.Series, now time.Time) error {
+	r, loc, err := parseRule(series.RRule, series.Timezone)
+	if err != nil {
+		return err
+	}
+	start := series.Start.In(loc)
+	from := *series.NextAt
+
+	// Occurrences missed while the scheduler was not running are skipped in
+	// favour of the latest one that has started.
+	at := from.In(loc)
+	for !at.After(now) {
+		following, ok := r.Next(start, at)
+		if !ok || following.After(now) {
+			break
+		}
+		at = following
+	}
+
+	task := &domain.Task{
+		ID:          uuid.New(),
+		Title:       series.Title,
+		Description: series.Description,
+		Priority:    series.Priority,
+		AssignedTo:  series.AssignedTo,
+		ProjectID:   series.ProjectID,
+		SeriesID:    &series.ID,
+		StartDate:   &at,
+	}
+	if series.DueAfter != nil {
+		due := at.Add(*series.DueAfter)
+		task.DueDate = &due
+	}
+
+	var labels []domain.Label
+	if previous, err := s.taskRepo.GetByID(ctx, series.CurrentTaskID); err == nil {
+		labels = previous.Labels
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return err
+	}
+
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.seriesRepo.Advance(ctx, series.ID, from, task.ID, at, nextAfter(r, start, at)); err != nil {
+			return err
+		}
+		if err := s.tasks.Create(ctx, task); err != nil {
+			return err
+		}
+		// A failed statement aborts the transaction, so a label that cannot
+		// be copied fails the occurrence.
+		for _, l := range labels {
+			if err := s.tasks.AttachLabel(ctx, task.ID, l.ID); err != nil {
+				return fmt.Errorf("copy label %s: %w", l.ID, err)
+			}
+		}
+		return nil
+	})
+}
+
+func parseRule(rule, timezone string) (*rrule.Rule, *time.Location, error) {
+	r, err := rrule.Parse(rule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", domain.ErrInvalidRecurrence, err)
+	}
+
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		timezone = "UTC"
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: unknown timezone %q", domain.ErrInvalidRecurrence, timezone)
+	}
+	return r, loc, nil
+}
+
+// nextAfter returns the start of the occurrence after at, or nil when the
+// rule is exhausted.
+func nextAfter(r *rrule.Rule, start, at time.Time) *time.Time {
+	next, ok := r.Next(start, at)
+	if !ok {
+		return nil
+	}
+	return &next
+}
+
+// applyTemplate copies the fields that occurrences share from a task to its series.
+func applyTemplate(series *domain.Series, task *domain.Task) {
+	series.Title = task.Title
+	series.Description = task.Description
+	series.Priority = task.Priority
+	series.AssignedTo = task.AssignedTo
+	series.DueAfter = nil
+	if task.StartDate != nil && task.DueDate != nil {
+		dueAfter := task.DueDate.Sub(*task.StartDate)
+		series.DueAfter = &dueAfter
+	}
+}
+
+func differsFromTemplate(task *domain.Task, series *domain.Series) bool {
+	return task.Title != series.Title ||
+		task.Description != series.Description ||
+		task.Priority != series.Priority ||
+		task.AssignedTo != series.AssignedTo
+}
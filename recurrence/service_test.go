@@ -0,0 +1,199 @@
+package recurrence
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/recurrence/mocks"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// passthroughTx runs transactional functions directly.
+type passthroughTx struct{}
+
+func (passthroughTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// allowAll lets every caller do everything.
+type allowAll struct{}
+
+func (allowAll) Require(context.Context, uuid.UUID, domain.Permission) error {
+	return nil
+}
+
+func newTestService() (*Service, *mocks.SeriesRepository, *mocks.TaskRepository, *mocks.TaskService) {
+	seriesRepo := new(mocks.SeriesRepository)
+	taskRepo := new(mocks.TaskRepository)
+	tasks := new(mocks.TaskService)
+	return NewService(seriesRepo, taskRepo, tasks, passthroughTx{}, allowAll{}), seriesRepo, taskRepo, tasks
+}
+
+func dailySeries(start time.Time) domain.Series {
+	next := start.AddDate(0, 0, 1)
+	return domain.Series{
+		ID:            uuid.New(),
+		ProjectID:     uuid.New(),
+		RRule:         "FREQ=DAILY",
+		Timezone:      "UTC",
+		Start:         start,
+		Title:         "Stand-up notes",
+		Priority:      domain.PriorityMedium,
+		CurrentTaskID: uuid.New(),
+		LastAt:        start,
+		NextAt:        &next,
+	}
+}
+
+func TestService_MaterializeDue_SkipsMissedOccurrences(t *testing.T) {
+	svc, seriesRepo, taskRepo, tasks := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	series := dailySeries(start)
+	// The scheduler was down for four days.
+	now := start.AddDate(0, 0, 5).Add(time.Hour)
+	latest, following := start.AddDate(0, 0, 5), start.AddDate(0, 0, 6)
+	label := domain.Label{ID: uuid.New()}
+
+	seriesRepo.On("ListDue", mock.Anything, now).Return([]domain.Series{series}, nil)
+	taskRepo.On("GetByID", mock.Anything, series.CurrentTaskID).
+		Return(&domain.Task{ID: series.CurrentTaskID, Labels: []domain.Label{label}}, nil)
+	seriesRepo.On("Advance", mock.Anything, series.ID, *series.NextAt, mock.Anything, latest, &following).Return(nil)
+	tasks.On("Create", mock.Anything, mock.MatchedBy(func(task *domain.Task) bool {
+		return task.StartDate.Equal(latest) && *task.SeriesID == series.ID && task.Title == series.Title
+	})).Return(nil)
+	tasks.On("AttachLabel", mock.Anything, mock.Anything, label.ID).Return(nil)
+
+	created, err := svc.MaterializeDue(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, created)
+	seriesRepo.AssertExpectations(t)
+	tasks.AssertExpectations(t)
+}
+
+func TestService_MaterializeDue_ConflictIsAnotherRun(t *testing.T) {
+	svc, seriesRepo, taskRepo, tasks := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	series := dailySeries(start)
+	now := start.AddDate(0, 0, 1)
+
+	seriesRepo.On("ListDue", mock.Anything, now).Return([]domain.Series{series}, nil)
+	taskRepo.On("GetByID", mock.Anything, series.CurrentTaskID).Return(nil, domain.ErrNotFound)
+	seriesRepo.On("Advance", mock.Anything, series.ID, *series.NextAt, mock.Anything, now, mock.Anything).
+		Return(domain.ErrConflict)
+
+	created, err := svc.MaterializeDue(context.Background(), now)
+
+	require.NoError(t, err)
+	assert.Zero(t, created)
+	tasks.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_MaterializeDue_LabelFailureFailsOccurrence(t *testing.T) {
+	svc, seriesRepo, taskRepo, tasks := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	series := dailySeries(start)
+	now := start.AddDate(0, 0, 1)
+	label := domain.Label{ID: uuid.New()}
+
+	seriesRepo.On("ListDue", mock.Anything, now).Return([]domain.Series{series}, nil)
+	taskRepo.On("GetByID", mock.Anything, series.CurrentTaskID).
+		Return(&domain.Task{ID: series.CurrentTaskID, Labels: []domain.Label{label}}, nil)
+	seriesRepo.On("Advance", mock.Anything, series.ID, *series.NextAt, mock.Anything, now, mock.Anything).Return(nil)
+	tasks.On("Create", mock.Anything, mock.Anything).Return(nil)
+	tasks.On("AttachLabel", mock.Anything, mock.Anything, label.ID).Return(errors.New("label gone"))
+
+	created, err := svc.MaterializeDue(context.Background(), now)
+
+	assert.Error(t, err)
+	assert.Zero(t, created)
+}
+
+func TestService_UpdateSeries(t *testing.T) {
+	svc, seriesRepo, taskRepo, tasks := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	series := dailySeries(start)
+	stored := &domain.Task{ID: uuid.New(), ProjectID: series.ProjectID, SeriesID: &series.ID}
+	// The request cannot point the update at another series.
+	other := uuid.New()
+	task := &domain.Task{
+		ID: stored.ID, SeriesID: &other,
+		Title: "Retro notes", Priority: domain.PriorityHigh,
+	}
+	unchanged := domain.Task{ID: uuid.New(), SeriesID: &series.ID, Title: "Retro notes", Priority: domain.PriorityHigh}
+	outdated := domain.Task{ID: uuid.New(), SeriesID: &series.ID, Title: series.Title, Priority: series.Priority}
+
+	taskRepo.On("GetByID", mock.Anything, stored.ID).Return(stored, nil)
+	seriesRepo.On("GetByID", mock.Anything, series.ID).Return(&series, nil)
+	tasks.On("Update", mock.Anything, task).Return(nil)
+	seriesRepo.On("Update", mock.Anything, mock.MatchedBy(func(s *domain.Series) bool {
+		return s.ID == series.ID && s.Title == "Retro notes" && s.Priority == domain.PriorityHigh
+	})).Return(nil)
+	taskRepo.On("ListOpenBySeries", mock.Anything, series.ID).
+		Return([]domain.Task{*task, unchanged, outdated}, nil)
+	tasks.On("Update", mock.Anything, mock.MatchedBy(func(o *domain.Task) bool {
+		return o.ID == outdated.ID && o.Title == "Retro notes" && o.Priority == domain.PriorityHigh
+	})).Return(nil)
+
+	err := svc.UpdateSeries(context.Background(), task)
+
+	require.NoError(t, err)
+	seriesRepo.AssertExpectations(t)
+	tasks.AssertExpectations(t)
+	tasks.AssertNumberOfCalls(t, "Update", 2)
+	seriesRepo.AssertNotCalled(t, "GetByID", mock.Anything, other)
+}
+
+func TestService_SetRecurrence_ReplacesActiveRule(t *testing.T) {
+	svc, seriesRepo, taskRepo, _ := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	series := dailySeries(start)
+	task := &domain.Task{ID: uuid.New(), ProjectID: series.ProjectID, SeriesID: &series.ID, StartDate: &start}
+
+	taskRepo.On("GetByID", mock.Anything, task.ID).Return(task, nil)
+	seriesRepo.On("GetByID", mock.Anything, series.ID).Return(&series, nil)
+	seriesRepo.On("Update", mock.Anything, mock.Anything).Return(nil)
+
+	updated, err := svc.SetRecurrence(context.Background(), task.ID, "FREQ=WEEKLY", "")
+
+	require.NoError(t, err)
+	assert.Equal(t, series.ID, updated.ID)
+	assert.Equal(t, "FREQ=WEEKLY", updated.RRule)
+	assert.Equal(t, start.AddDate(0, 0, 7), *updated.NextAt)
+	seriesRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_SetRecurrence_StartsNewSeriesAfterStopped(t *testing.T) {
+	svc, seriesRepo, taskRepo, _ := newTestService()
+	start := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	stopped := dailySeries(start)
+	stoppedAt := start.AddDate(0, 0, 3)
+	stopped.StoppedAt, stopped.NextAt = &stoppedAt, nil
+	task := &domain.Task{
+		ID: uuid.New(), ProjectID: stopped.ProjectID, SeriesID: &stopped.ID,
+		Title: "Stand-up notes", StartDate: &start,
+	}
+
+	taskRepo.On("GetByID", mock.Anything, task.ID).Return(task, nil)
+	seriesRepo.On("GetByID", mock.Anything, stopped.ID).Return(&stopped, nil)
+	seriesRepo.On("Create", mock.Anything, mock.MatchedBy(func(s *domain.Series) bool {
+		return s.ID != stopped.ID && s.CurrentTaskID == task.ID && s.Title == task.Title
+	})).Return(nil)
+	taskRepo.On("SetSeries", mock.Anything, task.ID, mock.Anything).Return(nil)
+
+	series, err := svc.SetRecurrence(context.Background(), task.ID, "FREQ=DAILY", "Europe/Berlin")
+
+	require.NoError(t, err)
+	assert.NotEqual(t, stopped.ID, series.ID)
+	assert.Equal(t, "Europe/Berlin", series.Timezone)
+	seriesRepo.AssertExpectations(t)
+	taskRepo.AssertExpectations(t)
+	seriesRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
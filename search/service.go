@@ -0,0 +1,97 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"task-manager/authz"
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Search returns hits by descending rank and the cursor of the next page,
+	// empty on the last one.
+	Search(ctx context.Context, query domain.SearchQuery, page pagination.Request) ([]domain.SearchHit, string, error)
+}
+
+type Service struct {
+	repo       Repository
+	authorizer authz.Authorizer
+}
+
+func NewService(repo Repository, authorizer authz.Authorizer) *Service {
+	return &Service{repo: repo, authorizer: authorizer}
+}
+
+// maxQueryLength keeps tsquery parsing and headline generation cheap.
+const maxQueryLength = 256
+
+func (s *Service) Search(
+	ctx context.Context,
+	query domain.SearchQuery,
+	page pagination.Request,
+) ([]domain.SearchHit, string, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	if query.Text == "" {
+		return nil, "", fmt.Errorf("%w: text is required", domain.ErrInvalidQuery)
+	}
+	if len(query.Text) > maxQueryLength {
+		return nil, "", fmt.Errorf("%w: text exceeds %d characters", domain.ErrInvalidQuery, maxQueryLength)
+	}
+
+	// Callers only find what they may read.
+	query.MemberID = nil
+	if query.ProjectID != nil {
+		if err := s.authorizer.Require(ctx, *query.ProjectID, domain.PermissionRead); err != nil {
+			return nil, "", err
+		}
+		return s.repo.Search(ctx, query, page)
+	}
+
+	// Across projects, limiting the query to the caller's own keeps the
+	// others out of the ranking; the hits are still checked one project at a
+	// time.
+	if !authz.Unrestricted(ctx) {
+		userID, ok := authctx.UserID(ctx)
+		if !ok {
+			return nil, "", fmt.Errorf("%w: no authenticated user", domain.ErrForbidden)
+		}
+		query.MemberID = &userID
+	}
+	hits, next, err := s.repo.Search(ctx, query, page)
+	if err != nil {
+		return nil, "", err
+	}
+	hits, err = s.readable(ctx, hits)
+	if err != nil {
+		return nil, "", err
+	}
+	return hits, next, nil
+}
+
+// readable drops the hits in projects the caller may not read.
+func (s *Service) readable(ctx context.Context, hits []domain.SearchHit) ([]domain.SearchHit, error) {
+	allowed := map[uuid.UUID]bool{}
+	kept := hits[:0]
+	for _, hit := range hits {
+		ok, checked := allowed[hit.ProjectID]
+		if !checked {
+			err := s.authorizer.Require(ctx, hit.ProjectID, domain.PermissionRead)
+			if err != nil && !errors.Is(err, domain.ErrForbidden) {
+				return nil, err
+			}
+			ok = err == nil
+			allowed[hit.ProjectID] = ok
+		}
+		if ok {
+			kept = append(kept, hit)
+		}
+	}
+	return kept, nil
+}
@@ -0,0 +1,54 @@
+package search_test
+
+import (
+	"context"
+	"testing"
+
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/pkg/pagination"
+	"task-manager/search"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubRepository struct {
+	hits  []domain.SearchHit
+	query domain.SearchQuery
+}
+
+func (r *stubRepository) Search(_ context.Context, query domain.SearchQuery, _ pagination.Request) ([]domain.SearchHit, string, error) {
+	r.query = query
+	return r.hits, "", nil
+}
+
+// readableProjects lets the caller read only the listed projects.
+type readableProjects map[uuid.UUID]bool
+
+func (p readableProjects) Require(_ context.Context, projectID uuid.UUID, _ domain.Permission) error {
+	if p[projectID] {
+		return nil
+	}
+	return domain.ErrForbidden
+}
+
+func TestService_Search_ChecksProjects(t *testing.T) {
+	readable, hidden := uuid.New(), uuid.New()
+	repo := &stubRepository{hits: []domain.SearchHit{
+		{TaskID: uuid.New(), ProjectID: readable},
+		{TaskID: uuid.New(), ProjectID: hidden},
+	}}
+	svc := search.NewService(repo, readableProjects{readable: true})
+	userID := uuid.New()
+	ctx := authctx.WithUserID(context.Background(), userID)
+
+	hits, _, err := svc.Search(ctx, domain.SearchQuery{Text: "report"}, pagination.Request{})
+	require.NoError(t, err)
+	assert.Equal(t, []domain.SearchHit{repo.hits[0]}, hits)
+	assert.Equal(t, &userID, repo.query.MemberID)
+
+	_, _, err = svc.Search(ctx, domain.SearchQuery{Text: "report", ProjectID: &hidden}, pagination.Request{})
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
@@ -0,0 +1,212 @@
+// Package stream fans task events out to the clients watching them live,
+// such as the board UI and backend services.
+package stream
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// Config tunes how much the broker keeps for its subscribers.
+type Config struct {
+	// History is how many recent events are kept so that a client that
+	// reconnects with the last event it saw can catch up.
+	History int
+	// Buffer is how many events may wait for a subscriber. A subscriber that
+	// falls further behind is dropped and has to reconnect.
+	Buffer int
+	// LogSize is how many events the shared log keeps for instances that
+	// fall behind or start up. It should be well above History.
+	LogSize int
+}
+
+var DefaultConfig = Config{
+	History: 1024,
+	Buffer:  64,
+	LogSize: 10000,
+}
+
+// ConfigFromEnv reads STREAM_HISTORY and STREAM_LOG_SIZE, falling back to
+// DefaultConfig.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+	for _, v := range []struct {
+		env string
+		dst *int
+	}{
+		{"STREAM_HISTORY", &cfg.History},
+		{"STREAM_LOG_SIZE", &cfg.LogSize},
+	} {
+		raw := os.Getenv(v.env)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 0 {
+			return Config{}, fmt.Errorf("invalid %s: %q", v.env, raw)
+		}
+		*v.dst = n
+	}
+	return cfg, nil
+}
+
+// Broker hands task events to everyone watching them on this instance. It is
+// fed by a Fanout, so it sees the events relayed by every instance.
+type Broker struct {
+	cfg Config
+
+	mu sync.Mutex
+	// history holds the most recent events, oldest first.
+	history []domain.TaskEvent
+	subs    map[*subscriber]struct{}
+}
+
+type subscriber struct {
+	match  func(domain.TaskEvent) bool
+	events chan domain.TaskEvent
+}
+
+func NewBroker(cfg Config) *Broker {
+	return &Broker{cfg: cfg, subs: make(map[*subscriber]struct{})}
+}
+
+// HandleTaskEvent passes the event on to the subscribers watching it. Events
+// already in the history are ignored, since an event may be passed on again.
+func (b *Broker) HandleTaskEvent(_ context.Context, event domain.TaskEvent) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, e := range b.history {
+		if e.ID == event.ID {
+			return nil
+		}
+	}
+	if b.cfg.History > 0 {
+		if len(b.history) == b.cfg.History {
+			b.history = append(b.history[:0], b.history[1:]...)
+		}
+		b.history = append(b.history, event)
+	}
+
+	for sub := range b.subs {
+		if !sub.match(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			delete(b.subs, sub)
+			close(sub.events)
+		}
+	}
+	return nil
+}
+
+// Subscribe starts receiving the events of a project. When lastEventID is
+// set, the events after it are delivered first; missed reports that it is no
+// longer in the history, so the caller should reload the project instead.
+// The events channel is closed by cancel, or when the subscriber falls
+// behind.
+func (b *Broker) Subscribe(
+	projectID uuid.UUID,
+	lastEventID uuid.UUID,
+) (events <-chan domain.TaskEvent, missed bool, cancel func()) {
+	var last func(domain.TaskEvent) bool
+	if lastEventID != uuid.Nil {
+		last = func(e domain.TaskEvent) bool { return e.ID == lastEventID }
+	}
+	return b.subscribe(inProject(projectID), last)
+}
+
+// WatchProject is Subscribe resuming after the event with the given outbox
+// sequence, or from now when afterSequence is 0.
+func (b *Broker) WatchProject(
+	projectID uuid.UUID,
+	afterSequence int64,
+) (events <-chan domain.TaskEvent, missed bool, cancel func()) {
+	return b.subscribe(inProject(projectID), afterSequenceOf(afterSequence))
+}
+
+// WatchAssignee streams the events of the tasks assigned to a user, across
+// projects, including the one that assigns a task away from them.
+func (b *Broker) WatchAssignee(
+	userID uuid.UUID,
+	afterSequence int64,
+) (events <-chan domain.TaskEvent, missed bool, cancel func()) {
+	assigned := func(e domain.TaskEvent) bool {
+		if e.Task.AssignedTo == userID {
+			return true
+		}
+		for _, c := range e.Changes {
+			if c.Field == "assigned_to" && c.From == userID.String() {
+				return true
+			}
+		}
+		return false
+	}
+	return b.subscribe(assigned, afterSequenceOf(afterSequence))
+}
+
+// subscribe registers a subscriber for the events that match. When last is
+// set, the matching events after the last event it identifies are replayed
+// from the history first.
+func (b *Broker) subscribe(
+	match func(domain.TaskEvent) bool,
+	last func(domain.TaskEvent) bool,
+) (<-chan domain.TaskEvent, bool, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var replay []domain.TaskEvent
+	missed := last != nil
+	if last != nil {
+		for i, e := range b.history {
+			if last(e) {
+				missed = false
+				for _, later := range b.history[i+1:] {
+					if match(later) {
+						replay = append(replay, later)
+					}
+				}
+				break
+			}
+		}
+	}
+
+	sub := &subscriber{match: match, events: make(chan domain.TaskEvent, b.cfg.Buffer+len(replay))}
+	for _, e := range replay {
+		sub.events <- e
+	}
+	b.subs[sub] = struct{}{}
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if _, ok := b.subs[sub]; ok {
+				delete(b.subs, sub)
+				close(sub.events)
+			}
+		})
+	}
+	return sub.events, missed, cancel
+}
+
+func inProject(projectID uuid.UUID) func(domain.TaskEvent) bool {
+	return func(e domain.TaskEvent) bool { return e.ProjectID == projectID }
+}
+
+func afterSequenceOf(sequence int64) func(domain.TaskEvent) bool {
+	if sequence == 0 {
+		return nil
+	}
+	return func(e domain.TaskEvent) bool { return e.Sequence == sequence }
+}
@@ -0,0 +1,99 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	"task-manager/domain"
+	"task-manager/stream"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func event(projectID uuid.UUID, eventType domain.TaskEventType) domain.TaskEvent {
+	return domain.TaskEvent{ID: uuid.New(), Type: eventType, ProjectID: projectID}
+}
+
+func drain(events <-chan domain.TaskEvent) []domain.TaskEvent {
+	var received []domain.TaskEvent
+	for {
+		select {
+		case e, open := <-events:
+			if !open {
+				return received
+			}
+			received = append(received, e)
+		default:
+			return received
+		}
+	}
+}
+
+func TestBroker_Subscribe_ResumesAfterLastEvent(t *testing.T) {
+	broker := stream.NewBroker(stream.Config{History: 3, Buffer: 8})
+	projectID := uuid.New()
+	created := event(projectID, domain.TaskCreated)
+	updated := event(projectID, domain.TaskUpdated)
+	commented := event(projectID, domain.TaskCommented)
+
+	live, _, cancel := broker.Subscribe(projectID, uuid.Nil)
+	defer cancel()
+	for _, e := range []domain.TaskEvent{created, updated, commented, updated} {
+		require.NoError(t, broker.HandleTaskEvent(context.Background(), e))
+	}
+	// Another project's events are not delivered.
+	require.NoError(t, broker.HandleTaskEvent(context.Background(), event(uuid.New(), domain.TaskCreated)))
+
+	// The redelivered update is only passed on once.
+	assert.Equal(t, []domain.TaskEvent{created, updated, commented}, drain(live))
+
+	resumed, missed, cancel := broker.Subscribe(projectID, updated.ID)
+	defer cancel()
+	assert.False(t, missed)
+	assert.Equal(t, []domain.TaskEvent{commented}, drain(resumed))
+
+	// created has dropped out of the history of three.
+	events, missed, cancel := broker.Subscribe(projectID, created.ID)
+	defer cancel()
+	assert.True(t, missed)
+	assert.Empty(t, drain(events))
+}
+
+func TestBroker_WatchAssignee_ResumesAfterSequence(t *testing.T) {
+	broker := stream.NewBroker(stream.DefaultConfig)
+	userID := uuid.New()
+	assigned := event(uuid.New(), domain.TaskAssigned)
+	assigned.Sequence, assigned.Task.AssignedTo = 1, userID
+	other := event(uuid.New(), domain.TaskCreated)
+	other.Sequence = 2
+	reassigned := event(assigned.ProjectID, domain.TaskAssigned)
+	reassigned.Sequence, reassigned.Task.AssignedTo = 3, uuid.New()
+	reassigned.Changes = []domain.FieldChange{{Field: "assigned_to", From: userID.String(), To: reassigned.Task.AssignedTo.String()}}
+
+	for _, e := range []domain.TaskEvent{assigned, other, reassigned} {
+		require.NoError(t, broker.HandleTaskEvent(context.Background(), e))
+	}
+
+	events, missed, cancel := broker.WatchAssignee(userID, assigned.Sequence)
+	defer cancel()
+	assert.False(t, missed)
+	// Being assigned away is the last event the user sees of the task.
+	assert.Equal(t, []domain.TaskEvent{reassigned}, drain(events))
+}
+
+func TestBroker_HandleTaskEvent_DropsSlowSubscribers(t *testing.T) {
+	broker := stream.NewBroker(stream.Config{History: 8, Buffer: 1})
+	projectID := uuid.New()
+	slow, _, cancel := broker.Subscribe(projectID, uuid.Nil)
+	defer cancel()
+
+	first := event(projectID, domain.TaskCreated)
+	require.NoError(t, broker.HandleTaskEvent(context.Background(), first))
+	require.NoError(t, broker.HandleTaskEvent(context.Background(), event(projectID, domain.TaskUpdated)))
+
+	assert.Equal(t, []domain.TaskEvent{first}, drain(slow))
+	_, open := <-slow
+	assert.False(t, open)
+}
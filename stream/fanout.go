@@ -0,0 +1,99 @@
+package stream
+
+import (
+	"context"
+
+	"task-manager/domain"
+)
+
+// followBatch is how many logged events Follow reads at a time.
+const followBatch = 256
+
+// Log is the stream log shared by every instance. Events keep the ID and
+// sequence the outbox gave them, and are logged once each, in the order they
+// were relayed.
+type Log interface {
+	// Append logs an event unless it is already logged, keeping only the
+	// newest keep events.
+	Append(ctx context.Context, event domain.TaskEvent, keep int) error
+	// After returns up to limit events logged after position, oldest first,
+	// and the position of the last one returned.
+	After(ctx context.Context, position int64, limit int) ([]domain.TaskEvent, int64, error)
+	// Latest returns the newest n events, oldest first, and the position of
+	// the newest.
+	Latest(ctx context.Context, n int) ([]domain.TaskEvent, int64, error)
+}
+
+// Fanout shares the task events between instances. As an outbox subscriber
+// it logs the events this instance relays; Follow passes everything logged,
+// by any instance, on to this instance's broker. Every broker thus sees the
+// same events in the same order, and a client can resume on any instance.
+type Fanout struct {
+	log    Log
+	broker *Broker
+	cfg    Config
+
+	// position is how far Follow has read the log, valid once started.
+	position int64
+	started  bool
+	appended chan struct{}
+}
+
+func NewFanout(log Log, broker *Broker, cfg Config) *Fanout {
+	return &Fanout{log: log, broker: broker, cfg: cfg, appended: make(chan struct{}, 1)}
+}
+
+// HandleTaskEvent logs an event relayed from the outbox. Logging it again is
+// a no-op.
+func (f *Fanout) HandleTaskEvent(ctx context.Context, event domain.TaskEvent) error {
+	if err := f.log.Append(ctx, event, f.cfg.LogSize); err != nil {
+		return err
+	}
+	select {
+	case f.appended <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Wake signals when this instance has logged an event.
+func (f *Fanout) Wake() <-chan struct{} {
+	return f.appended
+}
+
+// Follow passes the events logged since the previous call on to the broker
+// and returns how many there were. The first call starts with the newest
+// History events, so that clients can resume on an instance that has just
+// started. Follow must not be called concurrently.
+func (f *Fanout) Follow(ctx context.Context) (int, error) {
+	if !f.started {
+		events, position, err := f.log.Latest(ctx, f.cfg.History)
+		if err != nil {
+			return 0, err
+		}
+		f.pass(ctx, events)
+		f.position, f.started = position, true
+		return len(events), nil
+	}
+
+	total := 0
+	for {
+		events, position, err := f.log.After(ctx, f.position, followBatch)
+		if err != nil {
+			return total, err
+		}
+		f.pass(ctx, events)
+		f.position = position
+		total += len(events)
+		if len(events) < followBatch {
+			return total, nil
+		}
+	}
+}
+
+func (f *Fanout) pass(ctx context.Context, events []domain.TaskEvent) {
+	for _, e := range events {
+		// The broker never fails.
+		_ = f.broker.HandleTaskEvent(ctx, e)
+	}
+}
@@ -0,0 +1,87 @@
+package stream_test
+
+import (
+	"context"
+	"testing"
+
+	"task-manager/domain"
+	"task-manager/stream"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryLog is a stream.Log shared by the instances of a test.
+type memoryLog struct {
+	events []domain.TaskEvent
+	// first is the position of events[0].
+	first int64
+}
+
+func (l *memoryLog) Append(_ context.Context, event domain.TaskEvent, keep int) error {
+	for _, e := range l.events {
+		if e.ID == event.ID {
+			return nil
+		}
+	}
+	l.events = append(l.events, event)
+	if drop := len(l.events) - keep; drop > 0 {
+		l.events = l.events[drop:]
+		l.first += int64(drop)
+	}
+	return nil
+}
+
+func (l *memoryLog) After(_ context.Context, position int64, limit int) ([]domain.TaskEvent, int64, error) {
+	from := max(int(position-l.first)+1, 0)
+	to := min(from+limit, len(l.events))
+	if from >= to {
+		return nil, position, nil
+	}
+	return l.events[from:to], l.first + int64(to) - 1, nil
+}
+
+func (l *memoryLog) Latest(_ context.Context, n int) ([]domain.TaskEvent, int64, error) {
+	from := max(len(l.events)-n, 0)
+	return l.events[from:], l.first + int64(len(l.events)) - 1, nil
+}
+
+func instance(t *testing.T, log stream.Log) (*stream.Fanout, *stream.Broker) {
+	t.Helper()
+	cfg := stream.Config{History: 2, Buffer: 8, LogSize: 8}
+	broker := stream.NewBroker(cfg)
+	fanout := stream.NewFanout(log, broker, cfg)
+	_, err := fanout.Follow(context.Background())
+	require.NoError(t, err)
+	return fanout, broker
+}
+
+func TestFanout_SharesEventsBetweenInstances(t *testing.T) {
+	ctx := context.Background()
+	log := &memoryLog{first: 1}
+	projectID := uuid.New()
+	created := event(projectID, domain.TaskCreated)
+	updated := event(projectID, domain.TaskUpdated)
+
+	relaying, _ := instance(t, log)
+	other, broker := instance(t, log)
+	live, _, cancel := broker.Subscribe(projectID, uuid.Nil)
+	defer cancel()
+
+	// The relay may hand an event over again.
+	for _, e := range []domain.TaskEvent{created, updated, updated} {
+		require.NoError(t, relaying.HandleTaskEvent(ctx, e))
+	}
+	n, err := other.Follow(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, []domain.TaskEvent{created, updated}, drain(live))
+
+	// A client can resume on an instance that started after the events.
+	_, restarted := instance(t, log)
+	resumed, missed, cancel := restarted.Subscribe(projectID, created.ID)
+	defer cancel()
+	assert.False(t, missed)
+	assert.Equal(t, []domain.TaskEvent{updated}, drain(resumed))
+}
@@ -0,0 +1,82 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// ListActivity returns one page of a task's history, newest first, and the
+// cursor of the next page (empty on the last page).
+func (s *Service) ListActivity(
+	ctx context.Context,
+	taskID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Activity, string, error) {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionRead); err != nil {
+		return nil, "", err
+	}
+	return s.activityRepo.ListByTask(ctx, taskID, page)
+}
+
+func (s *Service) record(
+	ctx context.Context,
+	actorID, taskID uuid.UUID,
+	action domain.ActivityAction,
+	changes []domain.FieldChange,
+) error {
+	return s.activityRepo.Create(ctx, &domain.Activity{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		ActorID:   actorID,
+		Action:    action,
+		Changes:   changes,
+		CreatedAt: time.Now(),
+	})
+}
+
+// actorFrom returns the authenticated user of the request, or uuid.Nil for
+// changes made by the system.
+func actorFrom(ctx context.Context) uuid.UUID {
+	userID, _ := authctx.UserID(ctx)
+	return userID
+}
+
+// diffTask lists the user-editable fields that differ between two versions of a task.
+func diffTask(before, after *domain.Task) []domain.FieldChange {
+	var changes []domain.FieldChange
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, domain.FieldChange{Field: field, From: from, To: to})
+		}
+	}
+
+	add("title", before.Title, after.Title)
+	add("description", before.Description, after.Description)
+	add("status", before.Status, after.Status)
+	add("priority", string(before.Priority), string(after.Priority))
+	add("assigned_to", before.AssignedTo.String(), after.AssignedTo.String())
+	add("parent_id", formatUUID(before.ParentID), formatUUID(after.ParentID))
+	add("start_date", formatTime(before.StartDate), formatTime(after.StartDate))
+	add("due_date", formatTime(before.DueDate), formatTime(after.DueDate))
+	return changes
+}
+
+func formatUUID(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
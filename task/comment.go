@@ -0,0 +1,183 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+
+	"github.com/google/uuid"
+)
+
+// Comment adds a comment to a task, optionally as a reply to another comment
+// on the same task, and returns it.
+func (s *Service) Comment(
+	ctx context.Context,
+	taskID, userID uuid.UUID,
+	content string,
+	parentID *uuid.UUID,
+) (*domain.Comment, error) {
+	task, err := s.readTask(ctx, taskID, domain.PermissionComment)
+	if err != nil {
+		return nil, err
+	}
+
+	comment := &domain.Comment{
+		ID:        uuid.New(),
+		TaskID:    taskID,
+		ParentID:  parentID,
+		UserID:    userID,
+		Content:   strings.TrimSpace(content),
+		CreatedAt: time.Now(),
+	}
+	if comment.Content == "" {
+		return nil, fmt.Errorf("%w: content is required", domain.ErrInvalidComment)
+	}
+	if parentID != nil {
+		parent, err := s.commentRepo.GetByID(ctx, *parentID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: parent comment not found", domain.ErrInvalidComment)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if parent.TaskID != taskID {
+			return nil, fmt.Errorf("%w: parent comment belongs to another task", domain.ErrInvalidComment)
+		}
+	}
+
+	mentions := s.mentionsIn(ctx, comment.Content, "", task.ProjectID, taskID, &comment.ID, userID)
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Create(ctx, comment); err != nil {
+			return err
+		}
+		if err := s.saveMentions(ctx, mentions); err != nil {
+			return err
+		}
+		if err := s.record(ctx, userID, taskID, domain.ActivityCommented, nil); err != nil {
+			return err
+		}
+		event := taskEvent(domain.TaskCommented, task, userID)
+		event.Comment = comment
+		event.Mentions = mentions
+		return s.outbox.Append(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// ListComments returns one page of a task's comments, oldest first, and the
+// cursor of the next page (empty on the last page).
+func (s *Service) ListComments(
+	ctx context.Context,
+	taskID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Comment, string, error) {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionRead); err != nil {
+		return nil, "", err
+	}
+	return s.commentRepo.ListByTask(ctx, taskID, page)
+}
+
+// EditComment replaces the content of a comment, notifying whoever the new
+// content mentions. Only its author may edit it, and only while they may
+// still comment in the project.
+func (s *Service) EditComment(
+	ctx context.Context,
+	taskID, commentID, userID uuid.UUID,
+	content string,
+) (*domain.Comment, error) {
+	task, err := s.readTask(ctx, taskID, domain.PermissionComment)
+	if err != nil {
+		return nil, err
+	}
+	comment, err := s.taskComment(ctx, taskID, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.UserID != userID {
+		return nil, fmt.Errorf("%w: only the author can edit a comment", domain.ErrForbidden)
+	}
+
+	previous := comment.Content
+	comment.Content = strings.TrimSpace(content)
+	if comment.Content == "" {
+		return nil, fmt.Errorf("%w: content is required", domain.ErrInvalidComment)
+	}
+	now := time.Now()
+	comment.EditedAt = &now
+
+	mentions := s.mentionsIn(ctx, comment.Content, previous, task.ProjectID, taskID, &comment.ID, userID)
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Update(ctx, comment); err != nil {
+			return err
+		}
+		if err := s.saveMentions(ctx, mentions); err != nil {
+			return err
+		}
+		if err := s.record(ctx, userID, taskID, domain.ActivityCommentEdited, nil); err != nil {
+			return err
+		}
+		event := taskEvent(domain.CommentEdited, task, userID)
+		event.Comment = comment
+		event.Mentions = mentions
+		return s.outbox.Append(ctx, event)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return comment, nil
+}
+
+// DeleteComment moves a comment to the trash. Its author and those who may
+// delete in the project may delete it; replies are kept.
+func (s *Service) DeleteComment(ctx context.Context, taskID, commentID, userID uuid.UUID) error {
+	task, err := s.readTask(ctx, taskID, domain.PermissionComment)
+	if err != nil {
+		return err
+	}
+	comment, err := s.taskComment(ctx, taskID, commentID)
+	if err != nil {
+		return err
+	}
+	if comment.UserID != userID {
+		err := s.authorizer.Require(ctx, task.ProjectID, domain.PermissionDelete)
+		if errors.Is(err, domain.ErrForbidden) {
+			return fmt.Errorf("%w: only the author or a project administrator can delete a comment", domain.ErrForbidden)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.commentRepo.Delete(ctx, commentID, userID, now); err != nil {
+			return err
+		}
+		if err := s.record(ctx, userID, taskID, domain.ActivityCommentDeleted, nil); err != nil {
+			return err
+		}
+		event := taskEvent(domain.CommentDeleted, task, userID)
+		event.Comment = comment
+		return s.outbox.Append(ctx, event)
+	})
+}
+
+// taskComment loads a comment, reporting ErrNotFound when it is not on the task.
+func (s *Service) taskComment(ctx context.Context, taskID, commentID uuid.UUID) (*domain.Comment, error) {
+	comment, err := s.commentRepo.GetByID(ctx, commentID)
+	if err != nil {
+		return nil, err
+	}
+	if comment.TaskID != taskID {
+		return nil, domain.ErrNotFound
+	}
+	return comment, nil
+}
@@ -0,0 +1,23 @@
+package task
+
+import (
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// taskEvent describes a change to task by actorID. It is appended to the
+// outbox in the transaction of the change, so that subscribers hear of the
+// change exactly when it is committed.
+func taskEvent(eventType domain.TaskEventType, task *domain.Task, actorID uuid.UUID) domain.TaskEvent {
+	return domain.TaskEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		ProjectID:  task.ProjectID,
+		ActorID:    actorID,
+		OccurredAt: time.Now(),
+		Task:       *task,
+	}
+}
@@ -0,0 +1,114 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+
+	"github.com/google/uuid"
+)
+
+// maxMentions bounds the handles resolved per text, since each one may be a
+// directory lookup made while the write waits.
+const maxMentions = 20
+
+// mentionPattern matches "@handle" where the handle is a username, an email
+// address or a user ID. The @ must not follow a word character, so plain
+// email addresses in the text are not mistaken for mentions.
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@([\w.+-]+(?:@[\w-]+(?:\.[\w-]+)+)?)`)
+
+// mentionHandles returns the distinct handles mentioned in text, in order.
+func mentionHandles(text string) []string {
+	var handles []string
+	seen := map[string]bool{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := strings.TrimRight(match[1], ".")
+		key := strings.ToLower(handle)
+		if handle == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		handles = append(handles, handle)
+	}
+	return handles
+}
+
+// mentionsIn resolves the users mentioned in text but not already in
+// previous, leaving out the author and users who cannot read the project.
+// Only the first maxMentions new handles are resolved. Handles the directory
+// cannot resolve are skipped so that a lookup failure never blocks the write
+// itself.
+func (s *Service) mentionsIn(
+	ctx context.Context,
+	text, previous string,
+	projectID, taskID uuid.UUID,
+	commentID *uuid.UUID,
+	authorID uuid.UUID,
+) []domain.Mention {
+	known := map[string]bool{}
+	for _, handle := range mentionHandles(previous) {
+		known[strings.ToLower(handle)] = true
+	}
+
+	var mentions []domain.Mention
+	seen := map[uuid.UUID]bool{authorID: true}
+	now := time.Now()
+	resolved := 0
+	for _, handle := range mentionHandles(text) {
+		if known[strings.ToLower(handle)] {
+			continue
+		}
+		if resolved == maxMentions {
+			break
+		}
+		resolved++
+
+		userID, err := uuid.Parse(handle)
+		if err != nil {
+			var found bool
+			userID, found, err = s.users.FindUserID(ctx, handle)
+			if err != nil {
+				log.Printf("resolve mention %q: %v", handle, err)
+				continue
+			}
+			if !found {
+				continue
+			}
+		}
+		if seen[userID] {
+			continue
+		}
+		seen[userID] = true
+
+		err = s.authorizer.Require(authctx.AsUser(ctx, userID), projectID, domain.PermissionRead)
+		if err != nil {
+			if !errors.Is(err, domain.ErrForbidden) {
+				log.Printf("check mention of %s: %v", userID, err)
+			}
+			continue
+		}
+
+		mentions = append(mentions, domain.Mention{
+			ID:        uuid.New(),
+			UserID:    userID,
+			TaskID:    taskID,
+			CommentID: commentID,
+			AuthorID:  authorID,
+			CreatedAt: now,
+		})
+	}
+	return mentions
+}
+
+func (s *Service) saveMentions(ctx context.Context, mentions []domain.Mention) error {
+	if len(mentions) == 0 {
+		return nil
+	}
+	return s.mentionRepo.Create(ctx, mentions)
+}
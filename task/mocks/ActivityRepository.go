@@ -0,0 +1,88 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "task-manager/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	pagination "task-manager/pkg/pagination"
+
+	uuid "github.com/google/uuid"
+)
+
+// ActivityRepository is an autogenerated mock type for the ActivityRepository type
+type ActivityRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, activity
+func (_m *ActivityRepository) Create(ctx context.Context, activity *domain.Activity) error {
+	ret := _m.Called(ctx, activity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Activity) error); ok {
+		r0 = rf(ctx, activity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListByTask provides a mock function with given fields: ctx, taskID, page
+func (_m *ActivityRepository) ListByTask(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Activity, string, error) {
+	ret := _m.Called(ctx, taskID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTask")
+	}
+
+	var r0 []domain.Activity
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, pagination.Request) ([]domain.Activity, string, error)); ok {
+		return rf(ctx, taskID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, pagination.Request) []domain.Activity); ok {
+		r0 = rf(ctx, taskID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Activity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, pagination.Request) string); ok {
+		r1 = rf(ctx, taskID, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, pagination.Request) error); ok {
+		r2 = rf(ctx, taskID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// NewActivityRepository creates a new instance of ActivityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewActivityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ActivityRepository {
+	mock := &ActivityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
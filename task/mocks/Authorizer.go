@@ -0,0 +1,49 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "task-manager/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// Authorizer is an autogenerated mock type for the Authorizer type
+type Authorizer struct {
+	mock.Mock
+}
+
+// Require provides a mock function with given fields: ctx, projectID, permission
+func (_m *Authorizer) Require(ctx context.Context, projectID uuid.UUID, permission domain.Permission) error {
+	ret := _m.Called(ctx, projectID, permission)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Require")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, domain.Permission) error); ok {
+		r0 = rf(ctx, projectID, permission)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewAuthorizer creates a new instance of Authorizer. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAuthorizer(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Authorizer {
+	mock := &Authorizer{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
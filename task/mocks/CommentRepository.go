@@ -4,9 +4,14 @@ package mocks
 
 import (
 	context "context"
+	domain "task-manager/domain"
 
 	mock "github.com/stretchr/testify/mock"
 
+	pagination "task-manager/pkg/pagination"
+
+	time "time"
+
 	uuid "github.com/google/uuid"
 )
 
@@ -15,17 +20,120 @@ type CommentRepository struct {
 	mock.Mock
 }
 
-// Create provides a mock function with given fields: ctx, taskID, userID, content
-func (_m *CommentRepository) Create(ctx context.Context, taskID uuid.UUID, userID uuid.UUID, content string) error {
-	ret := _m.Called(ctx, taskID, userID, content)
+// Create provides a mock function with given fields: ctx, comment
+func (_m *CommentRepository) Create(ctx context.Context, comment *domain.Comment) error {
+	ret := _m.Called(ctx, comment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Create")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, string) error); ok {
-		r0 = rf(ctx, taskID, userID, content)
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Delete provides a mock function with given fields: ctx, id, deletedBy, at
+func (_m *CommentRepository) Delete(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID, at time.Time) error {
+	ret := _m.Called(ctx, id, deletedBy, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID, time.Time) error); ok {
+		r0 = rf(ctx, id, deletedBy, at)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *CommentRepository) GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *domain.Comment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Comment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Comment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListByTask provides a mock function with given fields: ctx, taskID, page
+func (_m *CommentRepository) ListByTask(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Comment, string, error) {
+	ret := _m.Called(ctx, taskID, page)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByTask")
+	}
+
+	var r0 []domain.Comment
+	var r1 string
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, pagination.Request) ([]domain.Comment, string, error)); ok {
+		return rf(ctx, taskID, page)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, pagination.Request) []domain.Comment); ok {
+		r0 = rf(ctx, taskID, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.Comment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, pagination.Request) string); ok {
+		r1 = rf(ctx, taskID, page)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uuid.UUID, pagination.Request) error); ok {
+		r2 = rf(ctx, taskID, page)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Update provides a mock function with given fields: ctx, comment
+func (_m *CommentRepository) Update(ctx context.Context, comment *domain.Comment) error {
+	ret := _m.Called(ctx, comment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Comment) error); ok {
+		r0 = rf(ctx, comment)
 	} else {
 		r0 = ret.Error(0)
 	}
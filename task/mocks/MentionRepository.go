@@ -0,0 +1,47 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "task-manager/domain"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MentionRepository is an autogenerated mock type for the MentionRepository type
+type MentionRepository struct {
+	mock.Mock
+}
+
+// Create provides a mock function with given fields: ctx, mentions
+func (_m *MentionRepository) Create(ctx context.Context, mentions []domain.Mention) error {
+	ret := _m.Called(ctx, mentions)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.Mention) error); ok {
+		r0 = rf(ctx, mentions)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewMentionRepository creates a new instance of MentionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMentionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MentionRepository {
+	mock := &MentionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,79 @@
+// Code generated by mockery v2.53.2. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	domain "task-manager/domain"
+
+	mock "github.com/stretchr/testify/mock"
+
+	uuid "github.com/google/uuid"
+)
+
+// WorkflowRepository is an autogenerated mock type for the WorkflowRepository type
+type WorkflowRepository struct {
+	mock.Mock
+}
+
+// GetByProject provides a mock function with given fields: ctx, projectID
+func (_m *WorkflowRepository) GetByProject(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error) {
+	ret := _m.Called(ctx, projectID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByProject")
+	}
+
+	var r0 *domain.Workflow
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) (*domain.Workflow, error)); ok {
+		return rf(ctx, projectID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID) *domain.Workflow); ok {
+		r0 = rf(ctx, projectID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.Workflow)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = rf(ctx, projectID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Save provides a mock function with given fields: ctx, workflow
+func (_m *WorkflowRepository) Save(ctx context.Context, workflow *domain.Workflow) error {
+	ret := _m.Called(ctx, workflow)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *domain.Workflow) error); ok {
+		r0 = rf(ctx, workflow)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewWorkflowRepository creates a new instance of WorkflowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWorkflowRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WorkflowRepository {
+	mock := &WorkflowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -2,9 +2,13 @@ package task
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
+	"task-manager/authz"
 	"task-manager/domain"
+	"task-manager/pkg/pagination"
 
 	"github.com/google/uuid"
 )
@@ -13,54 +17,608 @@ type Repository interface {
 	Create(ctx context.Context, task *domain.Task) error
 	GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
 	Update(ctx context.Context, task *domain.Task) error
-	Delete(ctx context.Context, id uuid.UUID) error
+	Delete(ctx context.Context, id, deletedBy uuid.UUID, at time.Time) error
+	ListSubtasks(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error)
+	GetTrashedByID(ctx context.Context, id uuid.UUID) (*domain.Task, error)
+	Restore(ctx context.Context, id uuid.UUID) error
+	Purge(ctx context.Context, before time.Time) (int64, error)
 }
 
+// CommentRepository stores task comments. Deleted comments are hidden from
+// GetByID and ListByTask.
 type CommentRepository interface {
-	Create(ctx context.Context, taskID, userID uuid.UUID, content string) error
+	Create(ctx context.Context, comment *domain.Comment) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Comment, error)
+	ListByTask(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Comment, string, error)
+	Update(ctx context.Context, comment *domain.Comment) error
+	Delete(ctx context.Context, id, deletedBy uuid.UUID, at time.Time) error
+}
+
+// WorkflowRepository stores project-defined workflows. GetByProject returns
+// nil when the project has not defined one.
+type WorkflowRepository interface {
+	GetByProject(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error)
+	Save(ctx context.Context, workflow *domain.Workflow) error
+}
+
+// DependencyRepository stores "blocker blocks blocked" edges between tasks.
+type DependencyRepository interface {
+	Add(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	Remove(ctx context.Context, blockerID, blockedID uuid.UUID) error
+	ListBlockerIDs(ctx context.Context, taskID uuid.UUID) ([]uuid.UUID, error)
+	ListBlockers(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error)
+	ListBlocked(ctx context.Context, taskID uuid.UUID) ([]domain.Task, error)
+}
+
+// LabelRepository looks up project labels and links them to tasks.
+type LabelRepository interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Label, error)
+	Attach(ctx context.Context, taskID, labelID uuid.UUID) error
+	Detach(ctx context.Context, taskID, labelID uuid.UUID) error
+}
+
+// ActivityRepository stores the history of tasks.
+type ActivityRepository interface {
+	Create(ctx context.Context, activity *domain.Activity) error
+	ListByTask(ctx context.Context, taskID uuid.UUID, page pagination.Request) ([]domain.Activity, string, error)
+}
+
+// MentionRepository stores who was mentioned where.
+type MentionRepository interface {
+	Create(ctx context.Context, mentions []domain.Mention) error
+}
+
+// UserDirectory resolves mention handles through the identity provider.
+type UserDirectory interface {
+	// FindUserID looks a user up by username, or by email when the handle
+	// contains "@". found is false when nobody matches.
+	FindUserID(ctx context.Context, handle string) (id uuid.UUID, found bool, err error)
+}
+
+// Outbox queues task events for the subscribers that react to them, such as
+// notifications and webhooks.
+type Outbox interface {
+	// Append stores events in the transaction of ctx. They are relayed once
+	// that transaction commits and never if it rolls back.
+	Append(ctx context.Context, events ...domain.TaskEvent) error
+}
+
+// Transactor runs fn in a database transaction that repositories called with
+// the ctx passed to fn take part in.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type Service struct {
-	repo        Repository
-	commentRepo CommentRepository
+	repo           Repository
+	commentRepo    CommentRepository
+	workflowRepo   WorkflowRepository
+	dependencyRepo DependencyRepository
+	labelRepo      LabelRepository
+	activityRepo   ActivityRepository
+	mentionRepo    MentionRepository
+	users          UserDirectory
+	outbox         Outbox
+	tx             Transactor
+	authorizer     authz.Authorizer
 }
 
-func NewService(repo Repository, commentRepo CommentRepository) *Service {
-	return &Service{repo: repo, commentRepo: commentRepo}
+func NewService(
+	repo Repository,
+	commentRepo CommentRepository,
+	workflowRepo WorkflowRepository,
+	dependencyRepo DependencyRepository,
+	labelRepo LabelRepository,
+	activityRepo ActivityRepository,
+	mentionRepo MentionRepository,
+	users UserDirectory,
+	outbox Outbox,
+	tx Transactor,
+	authorizer authz.Authorizer,
+) *Service {
+	return &Service{
+		repo:           repo,
+		commentRepo:    commentRepo,
+		workflowRepo:   workflowRepo,
+		dependencyRepo: dependencyRepo,
+		labelRepo:      labelRepo,
+		activityRepo:   activityRepo,
+		mentionRepo:    mentionRepo,
+		users:          users,
+		outbox:         outbox,
+		tx:             tx,
+		authorizer:     authorizer,
+	}
 }
 
 func (s *Service) Create(ctx context.Context, task *domain.Task) error {
-	return s.repo.Create(ctx, task)
+	// A subtask without a project goes in its parent's.
+	if task.ParentID != nil && task.ProjectID == uuid.Nil {
+		parent, err := s.readTask(ctx, *task.ParentID, domain.PermissionCreate)
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: parent task not found", domain.ErrInvalidTask)
+		}
+		if err != nil {
+			return err
+		}
+		task.ProjectID = parent.ProjectID
+	}
+	if err := s.authorizer.Require(ctx, task.ProjectID, domain.PermissionCreate); err != nil {
+		return err
+	}
+	if err := s.checkParent(ctx, task); err != nil {
+		return err
+	}
+
+	wf, err := s.workflow(ctx, task.ProjectID)
+	if err != nil {
+		return err
+	}
+
+	if task.Status == "" {
+		task.Status = wf.InitialStatus
+	}
+	if _, ok := findStatus(wf, task.Status); !ok {
+		return &domain.TransitionError{To: task.Status, Reason: "unknown status"}
+	}
+
+	if task.Priority == "" {
+		task.Priority = domain.PriorityMedium
+	}
+	if err := validateTask(task); err != nil {
+		return err
+	}
+	applyCompletion(wf, task, time.Now())
+
+	actorID := actorFrom(ctx)
+	mentions := s.mentionsIn(ctx, task.Description, "", task.ProjectID, task.ID, nil, actorID)
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Create(ctx, task); err != nil {
+			return err
+		}
+		if err := s.saveMentions(ctx, mentions); err != nil {
+			return err
+		}
+		if err := s.record(ctx, actorID, task.ID, domain.ActivityCreated, nil); err != nil {
+			return err
+		}
+		event := taskEvent(domain.TaskCreated, task, actorID)
+		event.Mentions = mentions
+		return s.outbox.Append(ctx, event)
+	})
 }
 
 func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
-	return s.repo.GetByID(ctx, id)
+	return s.readTask(ctx, id, domain.PermissionRead)
 }
 
+// Update writes a task on top of the version it was read at. It returns
+// domain.ErrVersionConflict when task.Version is no longer the current version.
 func (s *Service) Update(ctx context.Context, task *domain.Task) error {
-	return s.repo.Update(ctx, task)
+	current, err := s.repo.GetByID(ctx, task.ID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizer.Require(ctx, current.ProjectID, domain.PermissionEdit); err != nil {
+		return err
+	}
+	if task.AssignedTo != current.AssignedTo {
+		if err := s.authorizer.Require(ctx, current.ProjectID, domain.PermissionAssign); err != nil {
+			return err
+		}
+	}
+	if task.Version != current.Version {
+		return domain.ErrVersionConflict
+	}
+
+	// A task never moves between projects or series through an update.
+	task.ProjectID = current.ProjectID
+	task.SeriesID = current.SeriesID
+	if task.Priority == "" {
+		task.Priority = current.Priority
+	}
+
+	if err := validateTask(task); err != nil {
+		return err
+	}
+
+	if !sameParent(task.ParentID, current.ParentID) {
+		if err := s.checkParent(ctx, task); err != nil {
+			return err
+		}
+	}
+
+	if task.Status != current.Status {
+		wf, err := s.workflow(ctx, current.ProjectID)
+		if err != nil {
+			return err
+		}
+		if err := checkTransition(wf, current.Status, task.Status); err != nil {
+			return err
+		}
+		if err := s.checkBlockers(ctx, wf, current.Status, task.Status, task.ID); err != nil {
+			return err
+		}
+		applyCompletion(wf, task, time.Now())
+	}
+
+	changes := diffTask(current, task)
+	actorID := actorFrom(ctx)
+	// Only users newly mentioned in the description are notified.
+	mentions := s.mentionsIn(ctx, task.Description, current.Description, task.ProjectID, task.ID, nil, actorID)
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, task); err != nil {
+			return err
+		}
+		if err := s.saveMentions(ctx, mentions); err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			return nil
+		}
+		if err := s.record(ctx, actorID, task.ID, domain.ActivityUpdated, changes); err != nil {
+			return err
+		}
+
+		updated := taskEvent(domain.TaskUpdated, task, actorID)
+		updated.Changes = changes
+		updated.Mentions = mentions
+		events := []domain.TaskEvent{updated}
+		if task.AssignedTo != current.AssignedTo {
+			assigned := taskEvent(domain.TaskAssigned, task, actorID)
+			assigned.Changes = []domain.FieldChange{{
+				Field: "assigned_to",
+				From:  current.AssignedTo.String(),
+				To:    task.AssignedTo.String(),
+			}}
+			events = append(events, assigned)
+		}
+		return s.outbox.Append(ctx, events...)
+	})
+}
+
+// Delete moves a task and its comments to the trash. Tasks that still have
+// subtasks are refused with domain.ErrHasSubtasks rather than orphaning or
+// cascading to the children.
+func (s *Service) Delete(ctx context.Context, id, deletedBy uuid.UUID) error {
+	task, err := s.readTask(ctx, id, domain.PermissionDelete)
+	if err != nil {
+		return err
+	}
+	subtasks, err := s.repo.ListSubtasks(ctx, id)
+	if err != nil {
+		return err
+	}
+	if len(subtasks) > 0 {
+		return domain.ErrHasSubtasks
+	}
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Delete(ctx, id, deletedBy, time.Now()); err != nil {
+			return err
+		}
+		if err := s.record(ctx, deletedBy, id, domain.ActivityDeleted, nil); err != nil {
+			return err
+		}
+		return s.outbox.Append(ctx, taskEvent(domain.TaskDeleted, task, deletedBy))
+	})
+}
+
+// Restore takes a task out of the trash. A subtask cannot be restored while
+// its parent is still in the trash.
+func (s *Service) Restore(ctx context.Context, id uuid.UUID) (*domain.Task, error) {
+	task, err := s.repo.GetTrashedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizer.Require(ctx, task.ProjectID, domain.PermissionDelete); err != nil {
+		return nil, err
+	}
+
+	if task.ParentID != nil {
+		_, err := s.repo.GetByID(ctx, *task.ParentID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: parent task is in the trash", domain.ErrInvalidTask)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Restore(ctx, id); err != nil {
+			return err
+		}
+		return s.record(ctx, actorFrom(ctx), id, domain.ActivityRestored, nil)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetByID(ctx, id)
 }
 
-func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
-	return s.repo.Delete(ctx, id)
+// PurgeTrash permanently removes tasks and comments that have been in the
+// trash for longer than retention.
+func (s *Service) PurgeTrash(ctx context.Context, retention time.Duration) (int64, error) {
+	return s.repo.Purge(ctx, time.Now().Add(-retention))
+}
+
+// ListSubtasks lists the direct subtasks of a task.
+func (s *Service) ListSubtasks(ctx context.Context, parentID uuid.UUID) ([]domain.Task, error) {
+	if _, err := s.readTask(ctx, parentID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.repo.ListSubtasks(ctx, parentID)
 }
 
 func (s *Service) Assign(ctx context.Context, taskID, userID uuid.UUID) error {
-	task, err := s.repo.GetByID(ctx, taskID)
+	task, err := s.readTask(ctx, taskID, domain.PermissionAssign)
 	if err != nil {
 		return err
 	}
+	change := domain.FieldChange{Field: "assigned_to", From: task.AssignedTo.String(), To: userID.String()}
+	reassigned := task.AssignedTo != userID
 	task.AssignedTo = userID
-	return s.repo.Update(ctx, task)
+
+	actorID := actorFrom(ctx)
+	return s.tx.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.Update(ctx, task); err != nil {
+			return err
+		}
+		if err := s.record(ctx, actorID, taskID, domain.ActivityAssigned, []domain.FieldChange{change}); err != nil {
+			return err
+		}
+		if !reassigned {
+			return nil
+		}
+		event := taskEvent(domain.TaskAssigned, task, actorID)
+		event.Changes = []domain.FieldChange{change}
+		return s.outbox.Append(ctx, event)
+	})
 }
 
-func (s *Service) Comment(ctx context.Context, taskID, userID uuid.UUID, content string) error {
-	comment := domain.Comment{
-		ID:        uuid.New(),
-		TaskID:    taskID,
-		UserID:    userID,
-		Content:   content,
-		CreatedAt: time.Now(),
+// AttachLabel adds a label to a task. The label must belong to the task's project.
+func (s *Service) AttachLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
+	task, err := s.readTask(ctx, taskID, domain.PermissionEdit)
+	if err != nil {
+		return err
+	}
+
+	label, err := s.labelRepo.GetByID(ctx, labelID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return fmt.Errorf("%w: label not found", domain.ErrInvalidLabel)
+	}
+	if err != nil {
+		return err
+	}
+	if label.ProjectID != task.ProjectID {
+		return fmt.Errorf("%w: label belongs to another project", domain.ErrInvalidLabel)
+	}
+
+	return s.labelRepo.Attach(ctx, taskID, labelID)
+}
+
+func (s *Service) DetachLabel(ctx context.Context, taskID, labelID uuid.UUID) error {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionEdit); err != nil {
+		return err
+	}
+	return s.labelRepo.Detach(ctx, taskID, labelID)
+}
+
+// Workflow returns the workflow of a project, falling back to DefaultWorkflow.
+func (s *Service) Workflow(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+	return s.workflow(ctx, projectID)
+}
+
+func (s *Service) workflow(ctx context.Context, projectID uuid.UUID) (*domain.Workflow, error) {
+	wf, err := s.workflowRepo.GetByProject(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if wf == nil {
+		return DefaultWorkflow(projectID), nil
+	}
+	return wf, nil
+}
+
+// SetWorkflow validates and stores a project-specific workflow.
+func (s *Service) SetWorkflow(ctx context.Context, workflow *domain.Workflow) error {
+	if err := s.authorizer.Require(ctx, workflow.ProjectID, domain.PermissionManageProject); err != nil {
+		return err
+	}
+	if err := validateWorkflow(workflow); err != nil {
+		return err
+	}
+	return s.workflowRepo.Save(ctx, workflow)
+}
+
+// readTask loads a task once the caller is known to hold the permission in
+// its project.
+func (s *Service) readTask(ctx context.Context, id uuid.UUID, permission domain.Permission) (*domain.Task, error) {
+	return authz.ReadTask(ctx, s.authorizer, s.repo, id, permission)
+}
+
+func validateTask(task *domain.Task) error {
+	if !task.Priority.Valid() {
+		return fmt.Errorf("%w: unknown priority %q", domain.ErrInvalidTask, task.Priority)
+	}
+	if task.StartDate != nil && task.DueDate != nil && task.DueDate.Before(*task.StartDate) {
+		return fmt.Errorf("%w: due date is before start date", domain.ErrInvalidTask)
+	}
+	return nil
+}
+
+// checkParent verifies that a task may be placed under its ParentID: the
+// parent must exist in the same project and must be neither the task itself
+// nor one of its descendants. A task without a project inherits the parent's.
+func (s *Service) checkParent(ctx context.Context, task *domain.Task) error {
+	if task.ParentID == nil {
+		return nil
+	}
+
+	ancestorID := *task.ParentID
+	for depth := 0; ; depth++ {
+		if ancestorID == task.ID {
+			return fmt.Errorf("%w: parent task would create a cycle", domain.ErrInvalidTask)
+		}
+
+		ancestor, err := s.repo.GetByID(ctx, ancestorID)
+		if errors.Is(err, domain.ErrNotFound) {
+			return fmt.Errorf("%w: parent task not found", domain.ErrInvalidTask)
+		}
+		if err != nil {
+			return err
+		}
+
+		if depth == 0 {
+			if task.ProjectID == uuid.Nil {
+				task.ProjectID = ancestor.ProjectID
+			}
+			if ancestor.ProjectID != task.ProjectID {
+				return fmt.Errorf("%w: parent task belongs to another project", domain.ErrInvalidTask)
+			}
+		}
+
+		if ancestor.ParentID == nil {
+			return nil
+		}
+		ancestorID = *ancestor.ParentID
+	}
+}
+
+func sameParent(a, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// AddDependency records that blockerID must be completed before taskID can start.
+func (s *Service) AddDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	if taskID == blockerID {
+		return domain.ErrDependencyCycle
+	}
+	if _, err := s.readTask(ctx, taskID, domain.PermissionEdit); err != nil {
+		return err
+	}
+	if _, err := s.readTask(ctx, blockerID, domain.PermissionRead); err != nil {
+		return err
+	}
+
+	// The new edge closes a cycle if taskID already (transitively) blocks blockerID.
+	visited := map[uuid.UUID]bool{blockerID: true}
+	queue := []uuid.UUID{blockerID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		blockerIDs, err := s.dependencyRepo.ListBlockerIDs(ctx, current)
+		if err != nil {
+			return err
+		}
+		for _, id := range blockerIDs {
+			if id == taskID {
+				return domain.ErrDependencyCycle
+			}
+			if !visited[id] {
+				visited[id] = true
+				queue = append(queue, id)
+			}
+		}
+	}
+
+	return s.dependencyRepo.Add(ctx, blockerID, taskID)
+}
+
+func (s *Service) RemoveDependency(ctx context.Context, taskID, blockerID uuid.UUID) error {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionEdit); err != nil {
+		return err
+	}
+	return s.dependencyRepo.Remove(ctx, blockerID, taskID)
+}
+
+// ListDependencies returns the tasks blocking taskID and the tasks it blocks.
+// Dependencies may cross projects, so tasks in projects the caller may not
+// read are left out.
+func (s *Service) ListDependencies(ctx context.Context, taskID uuid.UUID) (*domain.TaskDependencies, error) {
+	if _, err := s.readTask(ctx, taskID, domain.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	blockedBy, err := s.dependencyRepo.ListBlockers(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+	blocks, err := s.dependencyRepo.ListBlocked(ctx, taskID)
+	if err != nil {
+		return nil, err
+	}
+
+	readable := map[uuid.UUID]bool{}
+	if blockedBy, err = s.readableTasks(ctx, blockedBy, readable); err != nil {
+		return nil, err
+	}
+	if blocks, err = s.readableTasks(ctx, blocks, readable); err != nil {
+		return nil, err
+	}
+	return &domain.TaskDependencies{BlockedBy: blockedBy, Blocks: blocks}, nil
+}
+
+// readableTasks drops the tasks in projects the caller may not read. readable
+// caches the answer per project across calls.
+func (s *Service) readableTasks(
+	ctx context.Context,
+	tasks []domain.Task,
+	readable map[uuid.UUID]bool,
+) ([]domain.Task, error) {
+	kept := tasks[:0]
+	for _, t := range tasks {
+		ok, checked := readable[t.ProjectID]
+		if !checked {
+			err := s.authorizer.Require(ctx, t.ProjectID, domain.PermissionRead)
+			if err != nil && !errors.Is(err, domain.ErrForbidden) {
+				return nil, err
+			}
+			ok = err == nil
+			readable[t.ProjectID] = ok
+		}
+		if ok {
+			kept = append(kept, t)
+		}
+	}
+	return kept, nil
+}
+
+// checkBlockers refuses to start or finish a task while one of its blockers is
+// still open. Moves within the same status category are not affected.
+func (s *Service) checkBlockers(ctx context.Context, wf *domain.Workflow, from, to string, taskID uuid.UUID) error {
+	target, _ := findStatus(wf, to)
+	if target.Category == domain.StatusCategoryTodo {
+		return nil
+	}
+	if current, ok := findStatus(wf, from); ok && current.Category == target.Category {
+		return nil
+	}
+
+	blockers, err := s.dependencyRepo.ListBlockers(ctx, taskID)
+	if err != nil {
+		return err
+	}
+
+	open := 0
+	for _, b := range blockers {
+		if b.CompletedAt == nil {
+			open++
+		}
+	}
+	if open > 0 {
+		return &domain.TransitionError{
+			From:   from,
+			To:     to,
+			Reason: fmt.Sprintf("blocked by %d open task(s)", open),
+		}
 	}
-	return s.commentRepo.Create(ctx, comment.TaskID, comment.UserID, comment.Content)
+	return nil
 }
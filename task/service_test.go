@@ -3,8 +3,11 @@ package task
 import (
 	"context"
 	"testing"
+	"time"
 
+	"task-manager/authz"
 	"task-manager/domain"
+	"task-manager/pkg/authctx"
 	"task-manager/task/mocks"
 
 	"github.com/google/uuid"
@@ -12,28 +15,267 @@ import (
 	"github.com/stretchr/testify/mock"
 )
 
+// passthroughTx runs transactional functions directly.
+type passthroughTx struct{}
+
+func (passthroughTx) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+// allowAll lets every caller do everything.
+type allowAll struct{}
+
+func (allowAll) Require(context.Context, uuid.UUID, domain.Permission) error {
+	return nil
+}
+
 func TestService_Create(t *testing.T) {
 	mockRepo := new(mocks.Repository)
 	mockCommentRepo := new(mocks.CommentRepository)
-	svc := NewService(mockRepo, mockCommentRepo)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
 
 	tk := &domain.Task{
 		ID:    uuid.New(),
 		Title: "Test Task",
 	}
 
+	mockWorkflowRepo.On("GetByProject", context.Background(), tk.ProjectID).Return(nil, nil)
 	mockRepo.On("Create", context.Background(), tk).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
 
 	err := svc.Create(context.Background(), tk)
 
 	assert.NoError(t, err)
+	assert.Equal(t, StatusOpen, tk.Status)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_Create_UnknownStatus(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	tk := &domain.Task{ID: uuid.New(), Title: "Test Task", Status: "Done"}
+
+	mockWorkflowRepo.On("GetByProject", context.Background(), tk.ProjectID).Return(nil, nil)
+
+	err := svc.Create(context.Background(), tk)
+
+	var transitionErr *domain.TransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_Update_AllowedTransition(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	projectID := uuid.New()
+	existing := &domain.Task{ID: uuid.New(), ProjectID: projectID, Status: StatusOpen, Priority: domain.PriorityMedium}
+	updated := &domain.Task{ID: existing.ID, Status: StatusInProgress}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), projectID).Return(nil, nil)
+	mockDependencyRepo.On("ListBlockers", context.Background(), existing.ID).Return([]domain.Task{}, nil)
+	mockRepo.On("Update", context.Background(), updated).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.NoError(t, err)
+	assert.Equal(t, projectID, updated.ProjectID)
 	mockRepo.AssertExpectations(t)
 }
 
+func TestService_Update_AppendsEvent(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	projectID := uuid.New()
+	assigneeID := uuid.New()
+	existing := &domain.Task{
+		ID: uuid.New(), ProjectID: projectID, Title: "Ship it", Status: StatusOpen,
+		Priority: domain.PriorityMedium, AssignedTo: assigneeID,
+	}
+	updated := &domain.Task{ID: existing.ID, Title: "Ship it", Status: StatusInProgress, AssignedTo: assigneeID}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), projectID).Return(nil, nil)
+	mockDependencyRepo.On("ListBlockers", context.Background(), existing.ID).Return([]domain.Task{}, nil)
+	mockRepo.On("Update", context.Background(), updated).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On(
+		"Append", context.Background(), mock.MatchedBy(
+			func(e domain.TaskEvent) bool {
+				return e.Type == domain.TaskUpdated && e.Task.ID == existing.ID && e.ProjectID == projectID &&
+					len(e.Changes) == 1 && e.Changes[0].Field == "status" && e.Changes[0].From == StatusOpen
+			},
+		),
+	).Return(nil).Once()
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.NoError(t, err)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestService_Update_RejectedTransition(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	existing := &domain.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: StatusOpen, Priority: domain.PriorityMedium}
+	updated := &domain.Task{ID: existing.ID, Status: StatusDone}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), existing.ProjectID).Return(nil, nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	var transitionErr *domain.TransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	assert.Equal(t, StatusOpen, transitionErr.From)
+	assert.Equal(t, StatusDone, transitionErr.To)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_Update_ProjectWorkflow(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	projectID := uuid.New()
+	wf := &domain.Workflow{
+		ProjectID:     projectID,
+		InitialStatus: "todo",
+		Statuses: []domain.WorkflowStatus{
+			{Name: "todo", Category: domain.StatusCategoryTodo},
+			{Name: "shipped", Category: domain.StatusCategoryDone},
+		},
+		Transitions: []domain.WorkflowTransition{{From: "todo", To: "shipped"}},
+	}
+	existing := &domain.Task{ID: uuid.New(), ProjectID: projectID, Status: "todo", Priority: domain.PriorityMedium}
+	updated := &domain.Task{ID: existing.ID, Status: "shipped"}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), projectID).Return(wf, nil)
+	mockDependencyRepo.On("ListBlockers", context.Background(), existing.ID).Return([]domain.Task{}, nil)
+	mockRepo.On("Update", context.Background(), updated).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_SetWorkflow_Invalid(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	wf := &domain.Workflow{
+		ProjectID:     uuid.New(),
+		InitialStatus: "todo",
+		Statuses:      []domain.WorkflowStatus{{Name: "todo", Category: domain.StatusCategoryTodo}},
+		Transitions:   []domain.WorkflowTransition{{From: "todo", To: "missing"}},
+	}
+
+	err := svc.SetWorkflow(context.Background(), wf)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidWorkflow)
+	mockWorkflowRepo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything)
+}
+
 func TestService_Assign(t *testing.T) {
 	mockRepo := new(mocks.Repository)
 	mockCommentRepo := new(mocks.CommentRepository)
-	svc := NewService(mockRepo, mockCommentRepo)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
 
 	taskID := uuid.New()
 	userID := uuid.New()
@@ -48,26 +290,831 @@ func TestService_Assign(t *testing.T) {
 			},
 		),
 	).Return(nil)
+	mockActivityRepo.On(
+		"Create", context.Background(), mock.MatchedBy(
+			func(a *domain.Activity) bool {
+				return a.TaskID == taskID && a.Action == domain.ActivityAssigned &&
+					len(a.Changes) == 1 && a.Changes[0].To == userID.String()
+			},
+		),
+	).Return(nil)
+	mockOutbox.On(
+		"Append", context.Background(), mock.MatchedBy(
+			func(e domain.TaskEvent) bool {
+				return e.Type == domain.TaskAssigned && e.Task.ID == taskID &&
+					len(e.Changes) == 1 && e.Changes[0].To == userID.String()
+			},
+		),
+	).Return(nil).Once()
 
 	err := svc.Assign(context.Background(), taskID, userID)
 
 	assert.NoError(t, err)
 	mockRepo.AssertExpectations(t)
+	mockActivityRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
 }
 
 func TestService_Comment(t *testing.T) {
 	mockRepo := new(mocks.Repository)
 	mockCommentRepo := new(mocks.CommentRepository)
-	svc := NewService(mockRepo, mockCommentRepo)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
 
 	taskID := uuid.New()
 	userID := uuid.New()
 	content := "This is awesome!"
 
-	mockCommentRepo.On("Create", context.Background(), taskID, userID, content).Return(nil)
+	mockRepo.On("GetByID", context.Background(), taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockCommentRepo.On(
+		"Create", context.Background(), mock.MatchedBy(
+			func(c *domain.Comment) bool {
+				return c.TaskID == taskID && c.UserID == userID && c.Content == content && c.ParentID == nil
+			},
+		),
+	).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
 
-	err := svc.Comment(context.Background(), taskID, userID, content)
+	comment, err := svc.Comment(context.Background(), taskID, userID, content, nil)
 
 	assert.NoError(t, err)
+	assert.NotEqual(t, uuid.Nil, comment.ID)
 	mockCommentRepo.AssertExpectations(t)
 }
+
+func TestService_Comment_Mentions(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	taskID := uuid.New()
+	authorID := uuid.New()
+	aliceID := uuid.New()
+	bobID := uuid.New()
+	content := "@alice@example.com and @" + bobID.String() + " can you review? Ping @nobody, not mail@example.com"
+
+	mockRepo.On("GetByID", context.Background(), taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockUsers.On("FindUserID", context.Background(), "alice@example.com").Return(aliceID, true, nil)
+	mockUsers.On("FindUserID", context.Background(), "nobody").Return(uuid.Nil, false, nil)
+	mockCommentRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockMentionRepo.On(
+		"Create", context.Background(), mock.MatchedBy(
+			func(mentions []domain.Mention) bool {
+				return len(mentions) == 2 &&
+					mentions[0].UserID == aliceID && mentions[1].UserID == bobID &&
+					mentions[0].AuthorID == authorID && mentions[0].CommentID != nil
+			},
+		),
+	).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On(
+		"Append", context.Background(), mock.MatchedBy(
+			func(e domain.TaskEvent) bool {
+				return e.Type == domain.TaskCommented && e.Task.ID == taskID && e.ActorID == authorID &&
+					e.Comment != nil && len(e.Mentions) == 2 &&
+					e.Mentions[0].UserID == aliceID && e.Mentions[1].UserID == bobID
+			},
+		),
+	).Return(nil).Once()
+
+	_, err := svc.Comment(context.Background(), taskID, authorID, content, nil)
+
+	assert.NoError(t, err)
+	mockUsers.AssertExpectations(t)
+	mockMentionRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+// readers lets only the listed users into the project.
+type readers map[uuid.UUID]bool
+
+func (r readers) Require(ctx context.Context, _ uuid.UUID, _ domain.Permission) error {
+	if userID, ok := authctx.UserID(ctx); ok && r[userID] {
+		return nil
+	}
+	return domain.ErrForbidden
+}
+
+func TestService_Comment_MentionsOnlyProjectReaders(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+
+	taskID := uuid.New()
+	authorID := uuid.New()
+	outsiderID := uuid.New()
+	members := readers{authorID: true}
+	content := "@" + outsiderID.String()
+	for i := 0; i < maxMentions+5; i++ {
+		memberID := uuid.New()
+		members[memberID] = true
+		content += " @" + memberID.String()
+	}
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, members,
+	)
+	ctx := authctx.WithUserID(context.Background(), authorID)
+
+	mockRepo.On("GetByID", ctx, taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockCommentRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockMentionRepo.On(
+		"Create", mock.Anything, mock.MatchedBy(
+			func(mentions []domain.Mention) bool {
+				// The outsider takes one of the resolved handles but is not notified.
+				if len(mentions) != maxMentions-1 {
+					return false
+				}
+				for _, m := range mentions {
+					if m.UserID == outsiderID {
+						return false
+					}
+				}
+				return true
+			},
+		),
+	).Return(nil)
+	mockActivityRepo.On("Create", mock.Anything, mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	_, err := svc.Comment(ctx, taskID, authorID, content, nil)
+
+	assert.NoError(t, err)
+	mockMentionRepo.AssertExpectations(t)
+}
+
+func TestService_Comment_ReplyToOtherTask(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	taskID := uuid.New()
+	parent := &domain.Comment{ID: uuid.New(), TaskID: uuid.New()}
+
+	mockRepo.On("GetByID", context.Background(), taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockCommentRepo.On("GetByID", context.Background(), parent.ID).Return(parent, nil)
+
+	_, err := svc.Comment(context.Background(), taskID, uuid.New(), "Agreed", &parent.ID)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidComment)
+	mockCommentRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_EditComment(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	comment := &domain.Comment{ID: uuid.New(), TaskID: uuid.New(), UserID: uuid.New(), Content: "First"}
+
+	mockRepo.On("GetByID", context.Background(), comment.TaskID).Return(&domain.Task{ID: comment.TaskID}, nil)
+	mockCommentRepo.On("GetByID", context.Background(), comment.ID).Return(comment, nil)
+	mockCommentRepo.On("Update", context.Background(), comment).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.MatchedBy(func(a *domain.Activity) bool {
+		return a.Action == domain.ActivityCommentEdited && a.ActorID == comment.UserID
+	})).Return(nil)
+	mockOutbox.On("Append", context.Background(), mock.MatchedBy(func(e domain.TaskEvent) bool {
+		return e.Type == domain.CommentEdited && e.Comment.Content == "Second"
+	})).Return(nil)
+
+	edited, err := svc.EditComment(context.Background(), comment.TaskID, comment.ID, comment.UserID, " Second ")
+
+	assert.NoError(t, err)
+	assert.Equal(t, "Second", edited.Content)
+	assert.NotNil(t, edited.EditedAt)
+	mockActivityRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestService_EditComment_NotAuthor(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	comment := &domain.Comment{ID: uuid.New(), TaskID: uuid.New(), UserID: uuid.New(), Content: "First"}
+
+	mockRepo.On("GetByID", context.Background(), comment.TaskID).Return(&domain.Task{ID: comment.TaskID}, nil)
+	mockCommentRepo.On("GetByID", context.Background(), comment.ID).Return(comment, nil)
+
+	_, err := svc.EditComment(context.Background(), comment.TaskID, comment.ID, uuid.New(), "Second")
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockCommentRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_DeleteComment_Admin(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	adminID := uuid.New()
+	ctx := authctx.WithRoles(context.Background(), []string{authctx.RoleAdmin})
+	comment := &domain.Comment{ID: uuid.New(), TaskID: uuid.New(), UserID: uuid.New()}
+
+	mockRepo.On("GetByID", ctx, comment.TaskID).Return(&domain.Task{ID: comment.TaskID}, nil)
+	mockCommentRepo.On("GetByID", ctx, comment.ID).Return(comment, nil)
+	mockCommentRepo.On("Delete", ctx, comment.ID, adminID, mock.AnythingOfType("time.Time")).Return(nil)
+	mockActivityRepo.On("Create", ctx, mock.MatchedBy(func(a *domain.Activity) bool {
+		return a.Action == domain.ActivityCommentDeleted && a.ActorID == adminID
+	})).Return(nil)
+	mockOutbox.On("Append", ctx, mock.MatchedBy(func(e domain.TaskEvent) bool {
+		return e.Type == domain.CommentDeleted && e.Comment.ID == comment.ID
+	})).Return(nil)
+
+	err := svc.DeleteComment(ctx, comment.TaskID, comment.ID, adminID)
+
+	assert.NoError(t, err)
+	mockCommentRepo.AssertExpectations(t)
+	mockActivityRepo.AssertExpectations(t)
+	mockOutbox.AssertExpectations(t)
+}
+
+func TestService_Update_CompletionTracking(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	existing := &domain.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: StatusReview, Priority: domain.PriorityHigh}
+	updated := &domain.Task{ID: existing.ID, Status: StatusDone}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), existing.ProjectID).Return(nil, nil)
+	mockDependencyRepo.On("ListBlockers", context.Background(), existing.ID).Return([]domain.Task{}, nil)
+	mockRepo.On("Update", context.Background(), updated).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, updated.CompletedAt)
+	assert.Equal(t, domain.PriorityHigh, updated.Priority)
+}
+
+func TestService_Create_DueBeforeStart(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	start := time.Date(2025, 3, 14, 9, 0, 0, 0, time.UTC)
+	due := start.Add(-time.Hour)
+	tk := &domain.Task{ID: uuid.New(), Title: "Test Task", StartDate: &start, DueDate: &due}
+
+	mockWorkflowRepo.On("GetByProject", context.Background(), tk.ProjectID).Return(nil, nil)
+
+	err := svc.Create(context.Background(), tk)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTask)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_Create_Subtask(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	parent := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	tk := &domain.Task{ID: uuid.New(), Title: "Child", ParentID: &parent.ID}
+
+	mockRepo.On("GetByID", context.Background(), parent.ID).Return(parent, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), parent.ProjectID).Return(nil, nil)
+	mockRepo.On("Create", context.Background(), tk).Return(nil)
+	mockActivityRepo.On("Create", context.Background(), mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Create(context.Background(), tk)
+
+	assert.NoError(t, err)
+	assert.Equal(t, parent.ProjectID, tk.ProjectID)
+	mockRepo.AssertExpectations(t)
+}
+
+// projectMembers is an authz.MemberRepository with a single project.
+type projectMembers struct {
+	projectID uuid.UUID
+	roles     map[uuid.UUID]domain.ProjectRole
+}
+
+func (m projectMembers) GetMember(_ context.Context, projectID, userID uuid.UUID) (*domain.ProjectMember, error) {
+	role, ok := m.roles[userID]
+	if projectID != m.projectID || !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &domain.ProjectMember{ProjectID: projectID, UserID: userID, Role: role}, nil
+}
+
+func TestService_Create_SubtaskAsMember(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	parent := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	memberID := uuid.New()
+	members := projectMembers{projectID: parent.ProjectID, roles: map[uuid.UUID]domain.ProjectRole{
+		memberID: domain.ProjectRoleMember,
+	}}
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, authz.NewRoleAuthorizer(members),
+	)
+
+	ctx := authctx.WithUserID(context.Background(), memberID)
+	// The project comes from the parent, as POST /tasks/:id/subtasks sends it.
+	tk := &domain.Task{ID: uuid.New(), Title: "Child", ParentID: &parent.ID}
+
+	mockRepo.On("GetByID", ctx, parent.ID).Return(parent, nil)
+	mockWorkflowRepo.On("GetByProject", ctx, parent.ProjectID).Return(nil, nil)
+	mockRepo.On("Create", ctx, tk).Return(nil)
+	mockActivityRepo.On("Create", ctx, mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Create(ctx, tk)
+
+	assert.NoError(t, err)
+	assert.Equal(t, parent.ProjectID, tk.ProjectID)
+	mockRepo.AssertExpectations(t)
+
+	// A viewer of the parent's project may not add to it.
+	viewerID := uuid.New()
+	members.roles[viewerID] = domain.ProjectRoleViewer
+	viewer := authctx.WithUserID(context.Background(), viewerID)
+	mockRepo.On("GetByID", viewer, parent.ID).Return(parent, nil)
+
+	err = svc.Create(viewer, &domain.Task{ID: uuid.New(), Title: "Child", ParentID: &parent.ID})
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+}
+
+func TestService_ListDependencies_HidesUnreadableProjects(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	task := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	memberID := uuid.New()
+	members := projectMembers{projectID: task.ProjectID, roles: map[uuid.UUID]domain.ProjectRole{
+		memberID: domain.ProjectRoleViewer,
+	}}
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, authz.NewRoleAuthorizer(members),
+	)
+
+	ctx := authctx.WithUserID(context.Background(), memberID)
+	sameProject := domain.Task{ID: uuid.New(), ProjectID: task.ProjectID}
+	elsewhere := domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+
+	mockRepo.On("GetByID", ctx, task.ID).Return(task, nil)
+	mockDependencyRepo.On("ListBlockers", ctx, task.ID).Return([]domain.Task{elsewhere, sameProject}, nil)
+	mockDependencyRepo.On("ListBlocked", ctx, task.ID).Return([]domain.Task{elsewhere}, nil)
+
+	deps, err := svc.ListDependencies(ctx, task.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []domain.Task{sameProject}, deps.BlockedBy)
+	assert.Empty(t, deps.Blocks)
+}
+
+func TestService_Create_CrossProjectParent(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	parent := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	tk := &domain.Task{ID: uuid.New(), ProjectID: uuid.New(), ParentID: &parent.ID}
+
+	mockRepo.On("GetByID", context.Background(), parent.ID).Return(parent, nil)
+
+	err := svc.Create(context.Background(), tk)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTask)
+	mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+}
+
+func TestService_Create_ForbiddenBeforeParentLookup(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, readers{},
+	)
+
+	parentID := uuid.New()
+	tk := &domain.Task{ID: uuid.New(), ProjectID: uuid.New(), ParentID: &parentID}
+
+	err := svc.Create(context.Background(), tk)
+
+	// Whether the parent exists is not revealed to outsiders.
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "GetByID", mock.Anything, mock.Anything)
+}
+
+func TestService_Update_ParentCycle(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	projectID := uuid.New()
+	root := &domain.Task{ID: uuid.New(), ProjectID: projectID, Priority: domain.PriorityMedium}
+	child := &domain.Task{ID: uuid.New(), ProjectID: projectID, ParentID: &root.ID}
+	updated := &domain.Task{ID: root.ID, ParentID: &child.ID}
+
+	mockRepo.On("GetByID", context.Background(), root.ID).Return(root, nil)
+	mockRepo.On("GetByID", context.Background(), child.ID).Return(child, nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTask)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_Delete_WithSubtasks(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	taskID := uuid.New()
+
+	mockRepo.On("GetByID", context.Background(), taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockRepo.On("ListSubtasks", context.Background(), taskID).Return([]domain.Task{{ID: uuid.New()}}, nil)
+
+	err := svc.Delete(context.Background(), taskID, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrHasSubtasks)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Delete_Forbidden(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	mockAuthorizer := new(mocks.Authorizer)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, mockAuthorizer,
+	)
+
+	existing := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockAuthorizer.On("Require", context.Background(), existing.ProjectID, domain.PermissionDelete).
+		Return(domain.ErrForbidden)
+
+	err := svc.Delete(context.Background(), existing.ID, uuid.New())
+
+	assert.ErrorIs(t, err, domain.ErrForbidden)
+	mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Update_BlockedByOpenTask(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	existing := &domain.Task{ID: uuid.New(), ProjectID: uuid.New(), Status: StatusOpen, Priority: domain.PriorityMedium}
+	updated := &domain.Task{ID: existing.ID, Status: StatusInProgress}
+	blocker := domain.Task{ID: uuid.New(), Status: StatusReview}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+	mockWorkflowRepo.On("GetByProject", context.Background(), existing.ProjectID).Return(nil, nil)
+	mockDependencyRepo.On("ListBlockers", context.Background(), existing.ID).Return([]domain.Task{blocker}, nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	var transitionErr *domain.TransitionError
+	assert.ErrorAs(t, err, &transitionErr)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_AddDependency_Cycle(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	// a blocks b, b blocks c; making c block a closes the loop.
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+
+	mockRepo.On("GetByID", context.Background(), a).Return(&domain.Task{ID: a}, nil)
+	mockRepo.On("GetByID", context.Background(), c).Return(&domain.Task{ID: c}, nil)
+	mockDependencyRepo.On("ListBlockerIDs", context.Background(), c).Return([]uuid.UUID{b}, nil)
+	mockDependencyRepo.On("ListBlockerIDs", context.Background(), b).Return([]uuid.UUID{a}, nil)
+
+	err := svc.AddDependency(context.Background(), a, c)
+
+	assert.ErrorIs(t, err, domain.ErrDependencyCycle)
+	mockDependencyRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_AddDependency(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	taskID, blockerID := uuid.New(), uuid.New()
+
+	mockRepo.On("GetByID", context.Background(), taskID).Return(&domain.Task{ID: taskID}, nil)
+	mockRepo.On("GetByID", context.Background(), blockerID).Return(&domain.Task{ID: blockerID}, nil)
+	mockDependencyRepo.On("ListBlockerIDs", context.Background(), blockerID).Return([]uuid.UUID{}, nil)
+	mockDependencyRepo.On("Add", context.Background(), blockerID, taskID).Return(nil)
+
+	err := svc.AddDependency(context.Background(), taskID, blockerID)
+
+	assert.NoError(t, err)
+	mockDependencyRepo.AssertExpectations(t)
+}
+
+func TestService_AttachLabel_OtherProject(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	task := &domain.Task{ID: uuid.New(), ProjectID: uuid.New()}
+	label := &domain.Label{ID: uuid.New(), ProjectID: uuid.New()}
+
+	mockRepo.On("GetByID", context.Background(), task.ID).Return(task, nil)
+	mockLabelRepo.On("GetByID", context.Background(), label.ID).Return(label, nil)
+
+	err := svc.AttachLabel(context.Background(), task.ID, label.ID)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidLabel)
+	mockLabelRepo.AssertNotCalled(t, "Attach", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Restore_ParentInTrash(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	parentID := uuid.New()
+	trashed := &domain.Task{ID: uuid.New(), ParentID: &parentID}
+
+	mockRepo.On("GetTrashedByID", context.Background(), trashed.ID).Return(trashed, nil)
+	mockRepo.On("GetByID", context.Background(), parentID).Return(nil, domain.ErrNotFound)
+
+	_, err := svc.Restore(context.Background(), trashed.ID)
+
+	assert.ErrorIs(t, err, domain.ErrInvalidTask)
+	mockRepo.AssertNotCalled(t, "Restore", mock.Anything, mock.Anything)
+}
+
+func TestService_Update_StaleVersion(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	existing := &domain.Task{ID: uuid.New(), Status: StatusOpen, Priority: domain.PriorityMedium, Version: 4}
+	updated := &domain.Task{ID: existing.ID, Title: "Renamed", Status: StatusOpen, Version: 3}
+
+	mockRepo.On("GetByID", context.Background(), existing.ID).Return(existing, nil)
+
+	err := svc.Update(context.Background(), updated)
+
+	assert.ErrorIs(t, err, domain.ErrVersionConflict)
+	mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything)
+}
+
+func TestService_Update_RecordsChanges(t *testing.T) {
+	mockRepo := new(mocks.Repository)
+	mockCommentRepo := new(mocks.CommentRepository)
+	mockWorkflowRepo := new(mocks.WorkflowRepository)
+	mockDependencyRepo := new(mocks.DependencyRepository)
+	mockLabelRepo := new(mocks.LabelRepository)
+	mockActivityRepo := new(mocks.ActivityRepository)
+	mockMentionRepo := new(mocks.MentionRepository)
+	mockUsers := new(mocks.UserDirectory)
+	mockOutbox := new(mocks.Outbox)
+	svc := NewService(
+		mockRepo, mockCommentRepo, mockWorkflowRepo, mockDependencyRepo, mockLabelRepo, mockActivityRepo,
+		mockMentionRepo, mockUsers, mockOutbox, passthroughTx{}, allowAll{},
+	)
+
+	actorID := uuid.New()
+	ctx := authctx.WithUserID(context.Background(), actorID)
+	existing := &domain.Task{ID: uuid.New(), Title: "Old", Status: StatusOpen, Priority: domain.PriorityMedium}
+	updated := &domain.Task{ID: existing.ID, Title: "New", Status: StatusOpen, Priority: domain.PriorityHigh}
+
+	mockRepo.On("GetByID", ctx, existing.ID).Return(existing, nil)
+	mockRepo.On("Update", ctx, updated).Return(nil)
+	mockActivityRepo.On("Create", ctx, mock.Anything).Return(nil)
+	mockOutbox.On("Append", mock.Anything, mock.Anything).Return(nil)
+
+	err := svc.Update(ctx, updated)
+
+	assert.NoError(t, err)
+	activity := mockActivityRepo.Calls[0].Arguments.Get(1).(*domain.Activity)
+	assert.Equal(t, actorID, activity.ActorID)
+	assert.Equal(t, domain.ActivityUpdated, activity.Action)
+	assert.Equal(t, []domain.FieldChange{
+		{Field: "title", From: "Old", To: "New"},
+		{Field: "priority", From: "medium", To: "high"},
+	}, activity.Changes)
+}
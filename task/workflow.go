@@ -0,0 +1,123 @@
+package task
+
+import (
+	"fmt"
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// Statuses of the default workflow used by projects that have not defined their own.
+const (
+	StatusOpen       = "open"
+	StatusInProgress = "in_progress"
+	StatusReview     = "review"
+	StatusDone       = "done"
+)
+
+// DefaultWorkflow returns the built-in open → in_progress → review → done
+// workflow, with the ability to send work back and to reopen finished tasks.
+func DefaultWorkflow(projectID uuid.UUID) *domain.Workflow {
+	return &domain.Workflow{
+		ProjectID:     projectID,
+		InitialStatus: StatusOpen,
+		Statuses: []domain.WorkflowStatus{
+			{Name: StatusOpen, Category: domain.StatusCategoryTodo},
+			{Name: StatusInProgress, Category: domain.StatusCategoryInProgress},
+			{Name: StatusReview, Category: domain.StatusCategoryInProgress},
+			{Name: StatusDone, Category: domain.StatusCategoryDone},
+		},
+		Transitions: []domain.WorkflowTransition{
+			{From: StatusOpen, To: StatusInProgress},
+			{From: StatusInProgress, To: StatusOpen},
+			{From: StatusInProgress, To: StatusReview},
+			{From: StatusReview, To: StatusInProgress},
+			{From: StatusReview, To: StatusDone},
+			{From: StatusDone, To: StatusOpen},
+		},
+	}
+}
+
+func validateWorkflow(wf *domain.Workflow) error {
+	if len(wf.Statuses) == 0 {
+		return fmt.Errorf("%w: at least one status is required", domain.ErrInvalidWorkflow)
+	}
+
+	known := make(map[string]bool, len(wf.Statuses))
+	for _, st := range wf.Statuses {
+		if st.Name == "" {
+			return fmt.Errorf("%w: status name must not be empty", domain.ErrInvalidWorkflow)
+		}
+		if known[st.Name] {
+			return fmt.Errorf("%w: duplicate status %q", domain.ErrInvalidWorkflow, st.Name)
+		}
+		switch st.Category {
+		case domain.StatusCategoryTodo, domain.StatusCategoryInProgress, domain.StatusCategoryDone:
+		default:
+			return fmt.Errorf("%w: status %q has unknown category %q", domain.ErrInvalidWorkflow, st.Name, st.Category)
+		}
+		known[st.Name] = true
+	}
+
+	if !known[wf.InitialStatus] {
+		return fmt.Errorf("%w: initial status %q is not defined", domain.ErrInvalidWorkflow, wf.InitialStatus)
+	}
+
+	for _, tr := range wf.Transitions {
+		if !known[tr.From] || !known[tr.To] {
+			return fmt.Errorf("%w: transition %q → %q uses an undefined status", domain.ErrInvalidWorkflow, tr.From, tr.To)
+		}
+		if tr.From == tr.To {
+			return fmt.Errorf("%w: transition from %q to itself", domain.ErrInvalidWorkflow, tr.From)
+		}
+	}
+
+	return nil
+}
+
+func findStatus(wf *domain.Workflow, name string) (domain.WorkflowStatus, bool) {
+	for _, st := range wf.Statuses {
+		if st.Name == name {
+			return st, true
+		}
+	}
+	return domain.WorkflowStatus{}, false
+}
+
+// checkTransition verifies that a task may move from one status to another.
+// Tasks whose current status is no longer part of the workflow (for example
+// after the project redefined it) may move to any defined status.
+func checkTransition(wf *domain.Workflow, from, to string) error {
+	if _, ok := findStatus(wf, to); !ok {
+		return &domain.TransitionError{From: from, To: to, Reason: "unknown status"}
+	}
+	if from == to {
+		return nil
+	}
+	if _, ok := findStatus(wf, from); !ok {
+		return nil
+	}
+	for _, tr := range wf.Transitions {
+		if tr.From == from && tr.To == to {
+			return nil
+		}
+	}
+	return &domain.TransitionError{From: from, To: to, Reason: "transition not allowed by workflow"}
+}
+
+// applyCompletion keeps CompletedAt in line with the category of the task's status.
+func applyCompletion(wf *domain.Workflow, task *domain.Task, now time.Time) {
+	st, ok := findStatus(wf, task.Status)
+	if !ok {
+		return
+	}
+	if st.Category != domain.StatusCategoryDone {
+		task.CompletedAt = nil
+		return
+	}
+	if task.CompletedAt == nil {
+		task.CompletedAt = &now
+	}
+}
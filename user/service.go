@@ -2,24 +2,102 @@ package user
 
 import (
 	"context"
+	"time"
 
+	"task-manager/authz"
 	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	"task-manager/pkg/timeutil"
 
 	"github.com/google/uuid"
 )
 
 type TaskRepository interface {
-	ListByUser(ctx context.Context, userID uuid.UUID) ([]domain.Task, error)
+	ListByUser(
+		ctx context.Context,
+		userID uuid.UUID,
+		filter domain.TaskFilter,
+		sort domain.TaskSort,
+		page pagination.Request,
+	) ([]domain.Task, string, error)
+	ListOverdueByUser(ctx context.Context, userID uuid.UUID, now time.Time) ([]domain.Task, error)
+	ListDueBetweenByUser(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]domain.Task, error)
 }
 
+// MentionRepository lists where users were mentioned.
+type MentionRepository interface {
+	ListByUser(ctx context.Context, userID uuid.UUID, page pagination.Request) ([]domain.Mention, string, error)
+}
+
+// Service lists a user's own tasks and mentions. Only the user and realm
+// administrators may list them.
 type Service struct {
-	taskRepo TaskRepository
+	taskRepo    TaskRepository
+	mentionRepo MentionRepository
+}
+
+func NewService(taskRepo TaskRepository, mentionRepo MentionRepository) *Service {
+	return &Service{taskRepo: taskRepo, mentionRepo: mentionRepo}
+}
+
+// ListTasks returns one page of matching tasks and the cursor of the next
+// page (empty on the last page).
+func (s *Service) ListTasks(
+	ctx context.Context,
+	userID uuid.UUID,
+	filter domain.TaskFilter,
+	sort domain.TaskSort,
+	page pagination.Request,
+) ([]domain.Task, string, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, "", err
+	}
+	return s.taskRepo.ListByUser(ctx, userID, filter, sort, page)
+}
+
+// ListOverdueTasks lists uncompleted tasks assigned to the user whose due date has passed.
+func (s *Service) ListOverdueTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.ListOverdueByUser(ctx, userID, time.Now())
+}
+
+// ListTasksDueThisWeek lists uncompleted tasks assigned to the user that are
+// due in the current week, as observed in loc.
+func (s *Service) ListTasksDueThisWeek(
+	ctx context.Context,
+	userID uuid.UUID,
+	loc *time.Location,
+) ([]domain.Task, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+	from, to := timeutil.WeekRange(time.Now().In(loc))
+	return s.taskRepo.ListDueBetweenByUser(ctx, userID, from, to)
 }
 
-func NewService(taskRepo TaskRepository) *Service {
-	return &Service{taskRepo: taskRepo}
+// ListTasksDueBetween lists uncompleted tasks assigned to the user that are due in [from, to).
+func (s *Service) ListTasksDueBetween(
+	ctx context.Context,
+	userID uuid.UUID,
+	from, to time.Time,
+) ([]domain.Task, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, err
+	}
+	return s.taskRepo.ListDueBetweenByUser(ctx, userID, from, to)
 }
 
-func (s *Service) ListTasks(ctx context.Context, userID uuid.UUID) ([]domain.Task, error) {
-	return s.taskRepo.ListByUser(ctx, userID)
+// ListMentions returns one page of the places the user was mentioned, newest
+// first, and the cursor of the next page (empty on the last page).
+func (s *Service) ListMentions(
+	ctx context.Context,
+	userID uuid.UUID,
+	page pagination.Request,
+) ([]domain.Mention, string, error) {
+	if err := authz.RequireSelf(ctx, userID); err != nil {
+		return nil, "", err
+	}
+	return s.mentionRepo.ListByUser(ctx, userID, page)
 }
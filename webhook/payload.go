@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"task-manager/domain"
+
+	"github.com/google/uuid"
+)
+
+// PayloadVersion is bumped whenever the payload changes incompatibly.
+const PayloadVersion = 1
+
+// Headers sent with every delivery.
+const (
+	SignatureHeader = "X-Webhook-Signature"
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+)
+
+type payload struct {
+	Version    int       `json:"version"`
+	ID         uuid.UUID `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	ProjectID  uuid.UUID `json:"project_id"`
+	ActorID    *string   `json:"actor_id"`
+	Data       data      `json:"data"`
+}
+
+type data struct {
+	Task    taskPayload     `json:"task"`
+	Comment *commentPayload `json:"comment,omitempty"`
+	Changes []changePayload `json:"changes,omitempty"`
+}
+
+type taskPayload struct {
+	ID          uuid.UUID  `json:"id"`
+	ProjectID   uuid.UUID  `json:"project_id"`
+	ParentID    *uuid.UUID `json:"parent_id"`
+	Title       string     `json:"title"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	Priority    string     `json:"priority"`
+	AssignedTo  *uuid.UUID `json:"assigned_to"`
+	StartDate   *time.Time `json:"start_date"`
+	DueDate     *time.Time `json:"due_date"`
+	CompletedAt *time.Time `json:"completed_at"`
+	Version     int64      `json:"version"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+type commentPayload struct {
+	ID        uuid.UUID  `json:"id"`
+	ParentID  *uuid.UUID `json:"parent_id"`
+	UserID    uuid.UUID  `json:"user_id"`
+	Content   string     `json:"content"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type changePayload struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// encodePayload renders an event as the versioned JSON body of a delivery.
+func encodePayload(e domain.TaskEvent) (string, error) {
+	t := e.Task
+	p := payload{
+		Version:    PayloadVersion,
+		ID:         e.ID,
+		Type:       string(e.Type),
+		OccurredAt: e.OccurredAt.UTC(),
+		ProjectID:  e.ProjectID,
+		Data: data{
+			Task: taskPayload{
+				ID:          t.ID,
+				ProjectID:   t.ProjectID,
+				ParentID:    t.ParentID,
+				Title:       t.Title,
+				Description: t.Description,
+				Status:      t.Status,
+				Priority:    string(t.Priority),
+				StartDate:   t.StartDate,
+				DueDate:     t.DueDate,
+				CompletedAt: t.CompletedAt,
+				Version:     t.Version,
+				CreatedAt:   t.CreatedAt,
+				UpdatedAt:   t.UpdatedAt,
+			},
+		},
+	}
+	if e.ActorID != uuid.Nil {
+		actorID := e.ActorID.String()
+		p.ActorID = &actorID
+	}
+	if t.AssignedTo != uuid.Nil {
+		assignee := t.AssignedTo
+		p.Data.Task.AssignedTo = &assignee
+	}
+	if c := e.Comment; c != nil {
+		p.Data.Comment = &commentPayload{
+			ID:        c.ID,
+			ParentID:  c.ParentID,
+			UserID:    c.UserID,
+			Content:   c.Content,
+			CreatedAt: c.CreatedAt,
+		}
+	}
+	for _, c := range e.Changes {
+		p.Data.Changes = append(p.Data.Changes, changePayload{Field: c.Field, From: c.From, To: c.To})
+	}
+
+	body, err := json.Marshal(p)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// Sign returns the SignatureHeader value for a body sent at ts:
+// "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>" keyed with secret>".
+// Receivers should recompute v1 and reject stale timestamps.
+func Sign(secret string, ts time.Time, body []byte) string {
+	t := strconv.FormatInt(ts.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac(secret, t, body))
+}
+
+// Verify checks a SignatureHeader value against body, rejecting signatures
+// older than tolerance.
+func Verify(secret, header string, body []byte, now time.Time, tolerance time.Duration) error {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+	unix, err := strconv.ParseInt(t, 10, 64)
+	if err != nil {
+		return errors.New("malformed signature timestamp")
+	}
+	if age := now.Sub(time.Unix(unix, 0)); age > tolerance || age < -tolerance {
+		return errors.New("signature timestamp outside tolerance")
+	}
+	got, err := hex.DecodeString(v1)
+	if err != nil || !hmac.Equal(got, mac(secret, t, body)) {
+		return errors.New("signature mismatch")
+	}
+	return nil
+}
+
+func mac(secret, t string, body []byte) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(t))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
@@ -0,0 +1,482 @@
+// Package webhook lets projects subscribe URLs to task events and delivers
+// the events to them as signed HTTP requests.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"task-manager/authz"
+	"task-manager/domain"
+	"task-manager/pkg/authctx"
+	"task-manager/pkg/pagination"
+	"task-manager/pkg/safehttp"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, hook *domain.Webhook) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error)
+	ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error)
+	// ListSubscribed returns the active webhooks of a project that subscribe to t.
+	ListSubscribed(ctx context.Context, projectID uuid.UUID, t domain.TaskEventType) ([]domain.Webhook, error)
+	Update(ctx context.Context, hook *domain.Webhook) error
+	// Delete removes a webhook along with its delivery log.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
+
+type DeliveryRepository interface {
+	// Create stores deliveries, skipping those already stored.
+	Create(ctx context.Context, deliveries []domain.WebhookDelivery) error
+	GetByID(ctx context.Context, id uuid.UUID) (*domain.WebhookDelivery, error)
+	// ListByWebhook returns one page of a webhook's deliveries, newest first,
+	// and the cursor of the next page (empty on the last page).
+	ListByWebhook(ctx context.Context, webhookID uuid.UUID, page pagination.Request) ([]domain.WebhookDelivery, string, error)
+	ListAttempts(ctx context.Context, deliveryID uuid.UUID) ([]domain.WebhookAttempt, error)
+	// ClaimDue leases up to limit pending deliveries that are due, so that
+	// concurrent dispatchers do not send them twice.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]domain.WebhookDelivery, error)
+	// RecordAttempt saves the outcome of a delivery attempt and logs the attempt.
+	RecordAttempt(ctx context.Context, delivery *domain.WebhookDelivery, attempt *domain.WebhookAttempt) error
+}
+
+// Config tunes delivery retries.
+type Config struct {
+	// MaxAttempts is how often a delivery is tried before it is marked failed.
+	MaxAttempts int
+	// RetryBackoff is the wait after the first failed attempt. It doubles
+	// with every further failure, up to MaxBackoff.
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	// Timeout bounds each request to a subscriber.
+	Timeout time.Duration
+	// Lease is how long a claimed delivery is held before another dispatcher
+	// may try it again. It must exceed Timeout.
+	Lease     time.Duration
+	BatchSize int
+	// Targets decides which addresses subscriber URLs may point at.
+	Targets safehttp.Policy
+}
+
+var DefaultConfig = Config{
+	MaxAttempts:  8,
+	RetryBackoff: 30 * time.Second,
+	MaxBackoff:   6 * time.Hour,
+	Timeout:      10 * time.Second,
+	Lease:        5 * time.Minute,
+	BatchSize:    50,
+}
+
+// ConfigFromEnv reads WEBHOOK_MAX_ATTEMPTS, WEBHOOK_RETRY_BACKOFF and
+// WEBHOOK_ALLOW_PRIVATE_NETWORKS, falling back to DefaultConfig.
+func ConfigFromEnv() (Config, error) {
+	cfg := DefaultConfig
+	targets, err := safehttp.PolicyFromEnv()
+	if err != nil {
+		return Config{}, err
+	}
+	cfg.Targets = targets
+	if raw := os.Getenv("WEBHOOK_MAX_ATTEMPTS"); raw != "" {
+		n, err := strconv.Atoi(raw)
+		if err != nil || n < 1 {
+			return Config{}, fmt.Errorf("invalid WEBHOOK_MAX_ATTEMPTS: %q", raw)
+		}
+		cfg.MaxAttempts = n
+	}
+	if raw := os.Getenv("WEBHOOK_RETRY_BACKOFF"); raw != "" {
+		d, err := time.ParseDuration(raw)
+		if err != nil || d <= 0 {
+			return Config{}, fmt.Errorf("invalid WEBHOOK_RETRY_BACKOFF: %q", raw)
+		}
+		cfg.RetryBackoff = d
+	}
+	return cfg, nil
+}
+
+type Service struct {
+	repo       Repository
+	deliveries DeliveryRepository
+	authorizer authz.Authorizer
+	client     *http.Client
+	cfg        Config
+	wake       chan struct{}
+}
+
+func NewService(repo Repository, deliveries DeliveryRepository, authorizer authz.Authorizer, cfg Config) *Service {
+	return &Service{
+		repo:       repo,
+		deliveries: deliveries,
+		authorizer: authorizer,
+		client:     cfg.Targets.NewClient(cfg.Timeout),
+		cfg:        cfg,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// Create subscribes url to the given events of a project. A secret is
+// generated when none is given.
+func (s *Service) Create(
+	ctx context.Context,
+	projectID uuid.UUID,
+	rawURL, secret string,
+	events []domain.TaskEventType,
+) (*domain.Webhook, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionManageProject); err != nil {
+		return nil, err
+	}
+	createdBy, _ := authctx.UserID(ctx)
+	now := time.Now()
+	hook := &domain.Webhook{
+		ID:        uuid.New(),
+		ProjectID: projectID,
+		URL:       strings.TrimSpace(rawURL),
+		Secret:    secret,
+		Events:    events,
+		Active:    true,
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if hook.Secret == "" {
+		var err error
+		if hook.Secret, err = newSecret(); err != nil {
+			return nil, err
+		}
+	}
+	if err := validate(hook, s.cfg.Targets); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Create(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (s *Service) GetByID(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	return s.projectWebhook(ctx, id)
+}
+
+func (s *Service) ListByProject(ctx context.Context, projectID uuid.UUID) ([]domain.Webhook, error) {
+	if err := s.authorizer.Require(ctx, projectID, domain.PermissionManageProject); err != nil {
+		return nil, err
+	}
+	return s.repo.ListByProject(ctx, projectID)
+}
+
+// Update changes a webhook. Nil arguments leave their field unchanged.
+func (s *Service) Update(
+	ctx context.Context,
+	id uuid.UUID,
+	rawURL, secret *string,
+	events []domain.TaskEventType,
+	active *bool,
+) (*domain.Webhook, error) {
+	hook, err := s.projectWebhook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rawURL != nil {
+		hook.URL = strings.TrimSpace(*rawURL)
+	}
+	if secret != nil {
+		hook.Secret = *secret
+	}
+	if events != nil {
+		hook.Events = events
+	}
+	if active != nil {
+		hook.Active = *active
+	}
+	hook.UpdatedAt = time.Now()
+	if err := validate(hook, s.cfg.Targets); err != nil {
+		return nil, err
+	}
+	if err := s.repo.Update(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+func (s *Service) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, err := s.projectWebhook(ctx, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// projectWebhook loads a webhook once the caller is known to manage its
+// project. Webhooks carry their signing secret, so members cannot read them,
+// and to anyone else they do not exist.
+func (s *Service) projectWebhook(ctx context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	hook, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	err = s.authorizer.Require(ctx, hook.ProjectID, domain.PermissionManageProject)
+	if errors.Is(err, domain.ErrForbidden) {
+		return nil, domain.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// HandleTaskEvent queues an event for every active webhook of its project
+// that subscribes to it. Delivery happens in the background; see DeliverDue.
+//
+// Events are relayed at least once, so deliveries get IDs derived from the
+// event and a redelivered event is not queued twice.
+func (s *Service) HandleTaskEvent(ctx context.Context, event domain.TaskEvent) error {
+	hooks, err := s.repo.ListSubscribed(ctx, event.ProjectID, event.Type)
+	if err != nil || len(hooks) == 0 {
+		return err
+	}
+
+	body, err := encodePayload(event)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]domain.WebhookDelivery, 0, len(hooks))
+	for _, hook := range hooks {
+		deliveries = append(deliveries, domain.WebhookDelivery{
+			ID:            uuid.NewSHA1(event.ID, hook.ID[:]),
+			WebhookID:     hook.ID,
+			EventID:       event.ID,
+			EventType:     event.Type,
+			Payload:       body,
+			Status:        domain.DeliveryPending,
+			NextAttemptAt: now,
+			CreatedAt:     now,
+			UpdatedAt:     now,
+		})
+	}
+	if err := s.deliveries.Create(ctx, deliveries); err != nil {
+		return err
+	}
+	s.signal()
+	return nil
+}
+
+// Wake signals when new deliveries have been queued.
+func (s *Service) Wake() <-chan struct{} {
+	return s.wake
+}
+
+func (s *Service) signal() {
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// ListDeliveries returns one page of a webhook's delivery log, newest first.
+func (s *Service) ListDeliveries(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	page pagination.Request,
+) ([]domain.WebhookDelivery, string, error) {
+	if _, err := s.projectWebhook(ctx, webhookID); err != nil {
+		return nil, "", err
+	}
+	return s.deliveries.ListByWebhook(ctx, webhookID, page)
+}
+
+// Delivery returns a delivery of a webhook along with its attempts.
+func (s *Service) Delivery(
+	ctx context.Context,
+	webhookID, deliveryID uuid.UUID,
+) (*domain.WebhookDelivery, []domain.WebhookAttempt, error) {
+	if _, err := s.projectWebhook(ctx, webhookID); err != nil {
+		return nil, nil, err
+	}
+	d, err := s.deliveries.GetByID(ctx, deliveryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if d.WebhookID != webhookID {
+		return nil, nil, domain.ErrNotFound
+	}
+	attempts, err := s.deliveries.ListAttempts(ctx, deliveryID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return d, attempts, nil
+}
+
+// Replay queues a new delivery with the same payload as an earlier one,
+// whatever its outcome. The payload is signed afresh when it is sent.
+func (s *Service) Replay(ctx context.Context, webhookID, deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	original, _, err := s.Delivery(ctx, webhookID, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	replay := domain.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     original.WebhookID,
+		EventID:       original.EventID,
+		EventType:     original.EventType,
+		Payload:       original.Payload,
+		Status:        domain.DeliveryPending,
+		NextAttemptAt: now,
+		ReplayOf:      &original.ID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := s.deliveries.Create(ctx, []domain.WebhookDelivery{replay}); err != nil {
+		return nil, err
+	}
+	s.signal()
+	return &replay, nil
+}
+
+// DeliverDue attempts the pending deliveries that are due and returns how
+// many succeeded. Every attempt is logged. Failed attempts are retried with
+// exponential backoff until Config.MaxAttempts is reached.
+func (s *Service) DeliverDue(ctx context.Context, now time.Time) (int, error) {
+	due, err := s.deliveries.ClaimDue(ctx, now, s.cfg.Lease, s.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	var errs []error
+	hooks := make(map[uuid.UUID]*domain.Webhook)
+	for i := range due {
+		d := &due[i]
+
+		hook, ok := hooks[d.WebhookID]
+		if !ok {
+			if hook, err = s.repo.GetByID(ctx, d.WebhookID); err != nil && !errors.Is(err, domain.ErrNotFound) {
+				errs = append(errs, err)
+				continue
+			}
+			hooks[d.WebhookID] = hook
+		}
+
+		d.Attempts++
+		attempt := &domain.WebhookAttempt{ID: uuid.New(), DeliveryID: d.ID, Attempt: d.Attempts}
+		var sendErr error
+		start := time.Now()
+		switch {
+		case hook == nil:
+			sendErr = errors.New("webhook was deleted")
+		case !hook.Active:
+			sendErr = errors.New("webhook is inactive")
+		default:
+			attempt.StatusCode, sendErr = s.send(ctx, hook, d)
+		}
+		attempt.AttemptedAt = time.Now()
+		attempt.Duration = attempt.AttemptedAt.Sub(start)
+
+		switch {
+		case sendErr == nil:
+			d.Status = domain.DeliverySent
+			d.LastError = ""
+			sent++
+			log.Printf("webhook %s delivery %s (%s): attempt %d sent", d.WebhookID, d.ID, d.EventType, d.Attempts)
+		case hook == nil || !hook.Active || d.Attempts >= s.cfg.MaxAttempts:
+			attempt.Error = sendErr.Error()
+			d.Status = domain.DeliveryFailed
+			d.LastError = sendErr.Error()
+			log.Printf("webhook %s delivery %s (%s): attempt %d failed, giving up: %v",
+				d.WebhookID, d.ID, d.EventType, d.Attempts, sendErr)
+		default:
+			attempt.Error = sendErr.Error()
+			d.LastError = sendErr.Error()
+			d.NextAttemptAt = now.Add(s.backoff(d.Attempts))
+			log.Printf("webhook %s delivery %s (%s): attempt %d failed, retrying at %s: %v",
+				d.WebhookID, d.ID, d.EventType, d.Attempts, d.NextAttemptAt.Format(time.RFC3339), sendErr)
+		}
+
+		if err := s.deliveries.RecordAttempt(ctx, d, attempt); err != nil {
+			errs = append(errs, fmt.Errorf("record attempt of delivery %s: %w", d.ID, err))
+		}
+	}
+	return sent, errors.Join(errs...)
+}
+
+// send POSTs a delivery to its webhook and returns the response status.
+// Responses other than 2xx are failures.
+func (s *Service) send(ctx context.Context, hook *domain.Webhook, d *domain.WebhookDelivery) (int, error) {
+	body := []byte(d.Payload)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "task-manager-webhooks/"+strconv.Itoa(PayloadVersion))
+	req.Header.Set(EventHeader, string(d.EventType))
+	req.Header.Set(DeliveryHeader, d.ID.String())
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, time.Now(), body))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("subscriber responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff returns the wait after the given number of failed attempts.
+func (s *Service) backoff(attempts int) time.Duration {
+	wait := s.cfg.RetryBackoff
+	for i := 1; i < attempts && wait < s.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, s.cfg.MaxBackoff)
+}
+
+func validate(hook *domain.Webhook, targets safehttp.Policy) error {
+	if err := targets.CheckURL(hook.URL); err != nil {
+		return fmt.Errorf("%w: url %v", domain.ErrInvalidWebhook, err)
+	}
+	if len(hook.Secret) < 16 {
+		return fmt.Errorf("%w: secret must be at least 16 characters", domain.ErrInvalidWebhook)
+	}
+	if len(hook.Events) == 0 {
+		return fmt.Errorf("%w: subscribe to at least one event", domain.ErrInvalidWebhook)
+	}
+	seen := make(map[domain.TaskEventType]bool, len(hook.Events))
+	events := make([]domain.TaskEventType, 0, len(hook.Events))
+	for _, e := range hook.Events {
+		if !e.Valid() {
+			return fmt.Errorf("%w: unknown event %q", domain.ErrInvalidWebhook, e)
+		}
+		if !seen[e] {
+			seen[e] = true
+			events = append(events, e)
+		}
+	}
+	hook.Events = events
+	return nil
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
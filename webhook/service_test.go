@@ -0,0 +1,329 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"task-manager/domain"
+	"task-manager/pkg/pagination"
+	"task-manager/webhook"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memoryStore keeps webhooks and deliveries in memory for the service.
+type memoryStore struct {
+	mu         sync.Mutex
+	hooks      map[uuid.UUID]domain.Webhook
+	deliveries map[uuid.UUID]domain.WebhookDelivery
+	attempts   []domain.WebhookAttempt
+}
+
+func newMemoryStore() *memoryStore {
+	return &memoryStore{
+		hooks:      make(map[uuid.UUID]domain.Webhook),
+		deliveries: make(map[uuid.UUID]domain.WebhookDelivery),
+	}
+}
+
+func (m *memoryStore) Create(_ context.Context, hook *domain.Webhook) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hooks[hook.ID] = *hook
+	return nil
+}
+
+func (m *memoryStore) GetByID(_ context.Context, id uuid.UUID) (*domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	hook, ok := m.hooks[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &hook, nil
+}
+
+func (m *memoryStore) ListByProject(_ context.Context, projectID uuid.UUID) ([]domain.Webhook, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var hooks []domain.Webhook
+	for _, hook := range m.hooks {
+		if hook.ProjectID == projectID {
+			hooks = append(hooks, hook)
+		}
+	}
+	return hooks, nil
+}
+
+func (m *memoryStore) ListSubscribed(
+	ctx context.Context,
+	projectID uuid.UUID,
+	t domain.TaskEventType,
+) ([]domain.Webhook, error) {
+	hooks, _ := m.ListByProject(ctx, projectID)
+	var subscribed []domain.Webhook
+	for _, hook := range hooks {
+		if hook.Active && hook.Subscribes(t) {
+			subscribed = append(subscribed, hook)
+		}
+	}
+	return subscribed, nil
+}
+
+func (m *memoryStore) Update(ctx context.Context, hook *domain.Webhook) error {
+	return m.Create(ctx, hook)
+}
+
+func (m *memoryStore) Delete(_ context.Context, id uuid.UUID) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.hooks, id)
+	return nil
+}
+
+type memoryDeliveries struct{ *memoryStore }
+
+func (m memoryDeliveries) Create(_ context.Context, deliveries []domain.WebhookDelivery) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, d := range deliveries {
+		if _, ok := m.deliveries[d.ID]; !ok {
+			m.deliveries[d.ID] = d
+		}
+	}
+	return nil
+}
+
+func (m memoryDeliveries) GetByID(_ context.Context, id uuid.UUID) (*domain.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	d, ok := m.deliveries[id]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return &d, nil
+}
+
+func (m memoryDeliveries) ListByWebhook(
+	context.Context,
+	uuid.UUID,
+	pagination.Request,
+) ([]domain.WebhookDelivery, string, error) {
+	return nil, "", nil
+}
+
+func (m memoryDeliveries) ListAttempts(_ context.Context, deliveryID uuid.UUID) ([]domain.WebhookAttempt, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var attempts []domain.WebhookAttempt
+	for _, a := range m.attempts {
+		if a.DeliveryID == deliveryID {
+			attempts = append(attempts, a)
+		}
+	}
+	return attempts, nil
+}
+
+func (m memoryDeliveries) ClaimDue(
+	_ context.Context,
+	now time.Time,
+	lease time.Duration,
+	_ int,
+) ([]domain.WebhookDelivery, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var due []domain.WebhookDelivery
+	for id, d := range m.deliveries {
+		if d.Status == domain.DeliveryPending && !d.NextAttemptAt.After(now) {
+			d.NextAttemptAt = now.Add(lease)
+			m.deliveries[id] = d
+			due = append(due, d)
+		}
+	}
+	return due, nil
+}
+
+func (m memoryDeliveries) RecordAttempt(
+	_ context.Context,
+	delivery *domain.WebhookDelivery,
+	attempt *domain.WebhookAttempt,
+) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.deliveries[delivery.ID] = *delivery
+	m.attempts = append(m.attempts, *attempt)
+	return nil
+}
+
+// allowAll lets every caller manage every project.
+type allowAll struct{}
+
+func (allowAll) Require(context.Context, uuid.UUID, domain.Permission) error {
+	return nil
+}
+
+func newTestService(t *testing.T, handler http.HandlerFunc) (*webhook.Service, *domain.Webhook, memoryDeliveries) {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	store := newMemoryStore()
+	deliveries := memoryDeliveries{store}
+	// The test subscriber listens on loopback.
+	cfg := webhook.DefaultConfig
+	cfg.Targets.AllowPrivateNetworks = true
+	svc := webhook.NewService(store, deliveries, allowAll{}, cfg)
+
+	hook, err := svc.Create(
+		context.Background(), uuid.New(), server.URL, "0123456789abcdef0123456789abcdef",
+		[]domain.TaskEventType{domain.TaskCreated},
+	)
+	require.NoError(t, err)
+	return svc, hook, deliveries
+}
+
+func TestService_Create_RejectsInternalURLs(t *testing.T) {
+	store := newMemoryStore()
+	svc := webhook.NewService(store, memoryDeliveries{store}, allowAll{}, webhook.DefaultConfig)
+
+	for _, raw := range []string{"http://127.0.0.1:8080/hook", "http://169.254.169.254/latest/meta-data", "http://localhost/hook"} {
+		_, err := svc.Create(
+			context.Background(), uuid.New(), raw, "0123456789abcdef0123456789abcdef",
+			[]domain.TaskEventType{domain.TaskCreated},
+		)
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhook, raw)
+	}
+}
+
+// denyAll lets nobody manage any project.
+type denyAll struct{}
+
+func (denyAll) Require(context.Context, uuid.UUID, domain.Permission) error {
+	return domain.ErrForbidden
+}
+
+func TestService_GetByID_HidesOtherProjectsWebhooks(t *testing.T) {
+	store := newMemoryStore()
+	owner := webhook.NewService(store, memoryDeliveries{store}, allowAll{}, webhook.DefaultConfig)
+	hook, err := owner.Create(
+		context.Background(), uuid.New(), "https://example.com/hook", "0123456789abcdef0123456789abcdef",
+		[]domain.TaskEventType{domain.TaskCreated},
+	)
+	require.NoError(t, err)
+
+	outsider := webhook.NewService(store, memoryDeliveries{store}, denyAll{}, webhook.DefaultConfig)
+	_, err = outsider.GetByID(context.Background(), hook.ID)
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+	_, err = outsider.GetByID(context.Background(), uuid.New())
+	assert.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func taskEvent(projectID uuid.UUID, eventType domain.TaskEventType) domain.TaskEvent {
+	taskID := uuid.New()
+	return domain.TaskEvent{
+		ID:         uuid.New(),
+		Type:       eventType,
+		ProjectID:  projectID,
+		OccurredAt: time.Now(),
+		Task:       domain.Task{ID: taskID, ProjectID: projectID, Title: "Ship it", Status: "open"},
+	}
+}
+
+func TestService_DeliverDue_SendsSignedPayload(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	received := make(chan request, 1)
+	svc, hook, _ := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		received <- request{header: r.Header, body: body}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	event := taskEvent(hook.ProjectID, domain.TaskCreated)
+	require.NoError(t, svc.HandleTaskEvent(context.Background(), event))
+	// Not subscribed, so nothing is queued.
+	require.NoError(t, svc.HandleTaskEvent(context.Background(), taskEvent(hook.ProjectID, domain.TaskDeleted)))
+
+	sent, err := svc.DeliverDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+
+	req := <-received
+	assert.Equal(t, string(domain.TaskCreated), req.header.Get(webhook.EventHeader))
+	assert.NoError(t, webhook.Verify(hook.Secret, req.header.Get(webhook.SignatureHeader), req.body, time.Now(), time.Minute))
+	assert.Error(t, webhook.Verify("another secret", req.header.Get(webhook.SignatureHeader), req.body, time.Now(), time.Minute))
+
+	var payload struct {
+		Version int    `json:"version"`
+		ID      string `json:"id"`
+		Type    string `json:"type"`
+		Data    struct {
+			Task struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"task"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(req.body, &payload))
+	assert.Equal(t, webhook.PayloadVersion, payload.Version)
+	assert.Equal(t, event.ID.String(), payload.ID)
+	assert.Equal(t, event.Task.ID.String(), payload.Data.Task.ID)
+	assert.Equal(t, "Ship it", payload.Data.Task.Title)
+}
+
+func TestService_DeliverDue_RetriesFailuresAndReplays(t *testing.T) {
+	var mu sync.Mutex
+	status := http.StatusServiceUnavailable
+	svc, hook, deliveries := newTestService(t, func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.WriteHeader(status)
+	})
+
+	require.NoError(t, svc.HandleTaskEvent(context.Background(), taskEvent(hook.ProjectID, domain.TaskCreated)))
+
+	now := time.Now()
+	sent, err := svc.DeliverDue(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	var failed domain.WebhookDelivery
+	for _, d := range deliveries.deliveries {
+		failed = d
+	}
+	assert.Equal(t, domain.DeliveryPending, failed.Status)
+	assert.Equal(t, 1, failed.Attempts)
+	assert.Equal(t, now.Add(webhook.DefaultConfig.RetryBackoff), failed.NextAttemptAt)
+
+	_, attempts, err := svc.Delivery(context.Background(), hook.ID, failed.ID)
+	require.NoError(t, err)
+	require.Len(t, attempts, 1)
+	assert.Equal(t, http.StatusServiceUnavailable, attempts[0].StatusCode)
+
+	// Not due again until the backoff has passed.
+	sent, err = svc.DeliverDue(context.Background(), now)
+	require.NoError(t, err)
+	assert.Equal(t, 0, sent)
+
+	mu.Lock()
+	status = http.StatusOK
+	mu.Unlock()
+
+	replay, err := svc.Replay(context.Background(), hook.ID, failed.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &failed.ID, replay.ReplayOf)
+	assert.Equal(t, failed.Payload, replay.Payload)
+
+	sent, err = svc.DeliverDue(context.Background(), time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, 1, sent)
+}